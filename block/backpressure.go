@@ -0,0 +1,44 @@
+package block
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNodeOverloaded is returned when transactions are not admitted, because too many blocks are pending DA submission.
+var ErrNodeOverloaded = errors.New("node overloaded")
+
+// DABacklog describes blocks produced by the aggregator, but not yet submitted to DA layer.
+type DABacklog struct {
+	// PendingBlocks is the number of blocks pending DA submission.
+	PendingBlocks uint64 `json:"pending_blocks"`
+	// MaxPendingBlocks is the configured limit of blocks pending DA submission, 0 means no limit.
+	MaxPendingBlocks uint64 `json:"max_pending_blocks"`
+	// Overloaded is true if the limit is reached, and new transactions are rejected.
+	Overloaded bool `json:"overloaded"`
+}
+
+// DABacklog returns the number of blocks pending DA submission.
+//
+// Blocks are submitted to DA only by the active aggregator, on other nodes backlog is always empty.
+func (m *Manager) DABacklog() DABacklog {
+	backlog := DABacklog{MaxPendingBlocks: m.conf.MaxPendingBlocks}
+	if !m.isProposer || m.standby.Load() {
+		return backlog
+	}
+	backlog.PendingBlocks = m.pendingBlocks.numPendingBlocks()
+	backlog.Overloaded = backlog.MaxPendingBlocks != 0 && backlog.PendingBlocks >= backlog.MaxPendingBlocks
+	m.metrics.DABacklog.Set(float64(backlog.PendingBlocks))
+	return backlog
+}
+
+// CheckBackPressure returns ErrNodeOverloaded if the limit of blocks pending DA submission is reached.
+//
+// Block production is paused until pending blocks are submitted, so accepting new transactions would only grow the
+// mempool. It's used for admission of transactions to the mempool.
+func (m *Manager) CheckBackPressure() error {
+	if backlog := m.DABacklog(); backlog.Overloaded {
+		return fmt.Errorf("%w, DA backlog %d blocks", ErrNodeOverloaded, backlog.PendingBlocks)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+)
+
+func TestCheckBackPressure(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := store.New(kv)
+	pendingBlocks, err := NewPendingBlocks(s, test.NewLogger(t))
+	require.NoError(err)
+
+	m := &Manager{
+		conf:          config.BlockManagerConfig{MaxPendingBlocks: 3},
+		isProposer:    true,
+		pendingBlocks: pendingBlocks,
+		metrics:       NopMetrics(),
+	}
+	require.NoError(m.CheckBackPressure())
+
+	s.SetHeight(ctx, 2)
+	require.NoError(m.CheckBackPressure())
+	require.Equal(DABacklog{PendingBlocks: 2, MaxPendingBlocks: 3}, m.DABacklog())
+
+	s.SetHeight(ctx, 3)
+	err = m.CheckBackPressure()
+	require.ErrorIs(err, ErrNodeOverloaded)
+	require.EqualError(err, "node overloaded, DA backlog 3 blocks")
+	require.True(m.DABacklog().Overloaded)
+
+	pendingBlocks.setLastSubmittedHeight(ctx, 1)
+	require.NoError(m.CheckBackPressure())
+
+	// only aggregator submits blocks to DA
+	m.isProposer = false
+	s.SetHeight(ctx, 10)
+	require.NoError(m.CheckBackPressure())
+	require.Zero(m.DABacklog().PendingBlocks)
+
+	// no limit
+	m.isProposer = true
+	m.conf.MaxPendingBlocks = 0
+	require.NoError(m.CheckBackPressure())
+	require.Equal(uint64(9), m.DABacklog().PendingBlocks)
+}
@@ -0,0 +1,52 @@
+package block
+
+import (
+	"time"
+)
+
+// defaultMinBlockTime is used only if MinBlockTime is not configured for manager in adaptive mode
+const defaultMinBlockTime = 100 * time.Millisecond
+
+// defaultMaxBlockTime is used only if MaxBlockTime is not configured for manager in adaptive mode
+const defaultMaxBlockTime = 10 * time.Second
+
+// getBlockTime returns time between consecutive blocks in normal aggregator mode.
+//
+// In adaptive mode, block time is adjusted after every block, based on the mempool load.
+func (m *Manager) getBlockTime() time.Duration {
+	if !m.conf.AdaptiveBlockTime {
+		return m.conf.BlockTime
+	}
+	m.effectiveBlockTime = m.nextBlockTime(m.effectiveBlockTime)
+	m.metrics.EffectiveBlockTime.Set(m.effectiveBlockTime.Seconds())
+	return m.effectiveBlockTime
+}
+
+// nextBlockTime moves block time halfway from current towards the target block time.
+//
+// Target block time is MinBlockTime when mempool contains enough transactions to fill entire block, and MaxBlockTime
+// when mempool is empty. Blocks pending DA submission reduce the load, so aggregator doesn't speed up block production
+// when DA layer can't keep up.
+func (m *Manager) nextBlockTime(current time.Duration) time.Duration {
+	load := 0.0
+	if m.mempool != nil && m.maxBlockBytes > 0 {
+		load = float64(m.mempool.SizeBytes()) / float64(m.maxBlockBytes)
+	}
+	if load > 1 {
+		load = 1
+	}
+	if m.conf.MaxPendingBlocks != 0 {
+		pending := float64(m.pendingBlocks.numPendingBlocks()) / float64(m.conf.MaxPendingBlocks)
+		if pending > 1 {
+			pending = 1
+		}
+		load *= 1 - pending
+	}
+
+	span := m.conf.MaxBlockTime - m.conf.MinBlockTime
+	target := m.conf.MaxBlockTime - time.Duration(load*float64(span))
+	if current == 0 {
+		return target
+	}
+	return current + (target-current)/2
+}
@@ -0,0 +1,91 @@
+package block
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/mempool"
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+// sizedMempool is a mempool reporting constant size.
+type sizedMempool struct {
+	mempool.Mempool
+	sizeBytes int64
+}
+
+func (mp *sizedMempool) SizeBytes() int64 {
+	return mp.sizeBytes
+}
+
+func TestNextBlockTime(t *testing.T) {
+	conf := config.BlockManagerConfig{
+		AdaptiveBlockTime: true,
+		MinBlockTime:      100 * time.Millisecond,
+		MaxBlockTime:      1100 * time.Millisecond,
+	}
+	tests := []struct {
+		name      string
+		sizeBytes int64
+		current   time.Duration
+		expected  time.Duration
+	}{
+		{"idle", 0, 0, conf.MaxBlockTime},
+		{"full", 1000, 0, conf.MinBlockTime},
+		{"overloaded", 5000, 0, conf.MinBlockTime},
+		{"half full", 500, 0, 600 * time.Millisecond},
+		{"speeding up", 1000, conf.MaxBlockTime, 600 * time.Millisecond},
+		{"slowing down", 0, conf.MinBlockTime, 600 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Manager{conf: conf, mempool: &sizedMempool{sizeBytes: tt.sizeBytes}, maxBlockBytes: 1000}
+			assert.Equal(t, tt.expected, m.nextBlockTime(tt.current))
+		})
+	}
+}
+
+func TestNextBlockTimePendingBlocks(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := store.New(kv)
+	pendingBlocks, err := NewPendingBlocks(s, test.NewLogger(t))
+	require.NoError(err)
+
+	m := &Manager{
+		conf: config.BlockManagerConfig{
+			AdaptiveBlockTime: true,
+			MinBlockTime:      100 * time.Millisecond,
+			MaxBlockTime:      1100 * time.Millisecond,
+			MaxPendingBlocks:  4,
+		},
+		mempool:       &sizedMempool{sizeBytes: 1000},
+		maxBlockBytes: 1000,
+		pendingBlocks: pendingBlocks,
+	}
+	require.Equal(100*time.Millisecond, m.nextBlockTime(0))
+
+	for height := uint64(1); height <= 2; height++ {
+		block := types.GetRandomBlock(height, 0)
+		require.NoError(s.SaveBlock(ctx, block, &block.SignedHeader.Commit))
+		s.SetHeight(ctx, height)
+	}
+	require.Equal(600*time.Millisecond, m.nextBlockTime(0))
+
+	for height := uint64(3); height <= 4; height++ {
+		block := types.GetRandomBlock(height, 0)
+		require.NoError(s.SaveBlock(ctx, block, &block.SignedHeader.Commit))
+		s.SetHeight(ctx, height)
+	}
+	require.Equal(1100*time.Millisecond, m.nextBlockTime(0))
+}
@@ -0,0 +1,154 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	cmjson "github.com/cometbft/cometbft/libs/json"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// DivergenceEvidenceKey is the prefix of keys used for persisting evidence of sequencer publishing conflicting blocks.
+//
+// Evidence for a given height is stored under "DivergenceEvidenceKey/height" key.
+const DivergenceEvidenceKey = "divergence"
+
+// EventSequencerDivergence is published when sequencer is detected publishing conflicting blocks at the same height.
+const EventSequencerDivergence = "SequencerDivergence"
+
+// ErrSequencerDivergence is used when sequencer published conflicting blocks at the same height.
+var ErrSequencerDivergence = errors.New("sequencer published conflicting blocks")
+
+func init() {
+	cmjson.RegisterType(EventDataSequencerDivergence{}, "rollkit/event/SequencerDivergence")
+}
+
+// DivergenceEvidence contains two conflicting blocks, signed by the sequencer, at the same height.
+type DivergenceEvidence struct {
+	// Height of the conflicting blocks.
+	Height uint64 `json:"height"`
+	// FirstHash is the hash of the block that was received first.
+	FirstHash types.Hash `json:"first_hash"`
+	// SecondHash is the hash of the conflicting block.
+	SecondHash types.Hash `json:"second_hash"`
+	// First is the binary encoded block that was received first.
+	First []byte `json:"first"`
+	// Second is the binary encoded conflicting block.
+	Second []byte `json:"second"`
+	// Time when divergence was detected.
+	Time time.Time `json:"time"`
+}
+
+// EventDataSequencerDivergence is published with EventSequencerDivergence.
+type EventDataSequencerDivergence struct {
+	Height     uint64     `json:"height"`
+	FirstHash  types.Hash `json:"first_hash"`
+	SecondHash types.Hash `json:"second_hash"`
+}
+
+// IsSequencerFaulty returns true if sequencer was detected publishing conflicting blocks.
+func (m *Manager) IsSequencerFaulty() bool {
+	return m.sequencerFaulty.Load()
+}
+
+// GetDivergenceEvidence returns evidence of sequencer publishing conflicting blocks at given height.
+func (m *Manager) GetDivergenceEvidence(ctx context.Context, height uint64) (*DivergenceEvidence, error) {
+	raw, err := m.store.GetMetadata(ctx, divergenceEvidenceKey(height))
+	if err != nil {
+		return nil, err
+	}
+	evidence := new(DivergenceEvidence)
+	if err := json.Unmarshal(raw, evidence); err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
+
+// checkDivergence compares incoming block with the block already known at the same height.
+//
+// Known block is either already synced and stored, or waiting in the block cache. ErrSequencerDivergence is returned
+// if blocks are different.
+func (m *Manager) checkDivergence(ctx context.Context, block *types.Block) error {
+	height := block.Height()
+	var known *types.Block
+	if height <= m.store.Height() {
+		stored, err := m.store.GetBlock(ctx, height)
+		if err != nil {
+			return nil
+		}
+		known = stored
+	} else if cached, ok := m.blockCache.getBlock(height); ok {
+		known = cached
+	}
+	if known == nil || bytes.Equal(known.Hash(), block.Hash()) {
+		return nil
+	}
+	return m.recordDivergence(ctx, known, block)
+}
+
+// recordDivergence persists the evidence, notifies subscribers and flags the sequencer as faulty.
+//
+// If HaltOnDivergence is configured, node is halted.
+func (m *Manager) recordDivergence(ctx context.Context, first, second *types.Block) error {
+	height := first.Height()
+	divErr := fmt.Errorf("%w: height %d, hashes %s and %s", ErrSequencerDivergence, height, first.Hash(), second.Hash())
+
+	key := divergenceEvidenceKey(height)
+	if _, getErr := m.store.GetMetadata(ctx, key); errors.Is(getErr, ds.ErrNotFound) {
+		m.logger.Error("sequencer misbehavior detected", "height", height, "firstHash", first.Hash(), "secondHash", second.Hash())
+		m.sequencerFaulty.Store(true)
+		m.metrics.DivergentBlocks.Add(1)
+
+		if err := m.saveDivergenceEvidence(ctx, key, first, second); err != nil {
+			m.logger.Error("failed to save divergence evidence", "height", height, "error", err)
+		}
+		if m.evpool != nil {
+			if err := m.addDuplicateVoteEvidence(ctx, first, second); err != nil {
+				m.logger.Error("failed to add divergence evidence to evidence pool", "height", height, "error", err)
+			}
+		}
+		if m.eventBus != nil {
+			data := EventDataSequencerDivergence{Height: height, FirstHash: first.Hash(), SecondHash: second.Hash()}
+			if err := m.eventBus.Publish(EventSequencerDivergence, data); err != nil {
+				m.logger.Error("failed to publish divergence event", "error", err)
+			}
+		}
+	}
+
+	if m.conf.HaltOnDivergence {
+		return m.halt(ctx, second, divErr)
+	}
+	return divErr
+}
+
+func (m *Manager) saveDivergenceEvidence(ctx context.Context, key string, first, second *types.Block) error {
+	evidence := DivergenceEvidence{
+		Height:     first.Height(),
+		FirstHash:  first.Hash(),
+		SecondHash: second.Hash(),
+		Time:       time.Now(),
+	}
+	var err error
+	if evidence.First, err = first.MarshalBinary(); err != nil {
+		return err
+	}
+	if evidence.Second, err = second.MarshalBinary(); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(evidence)
+	if err != nil {
+		return err
+	}
+	return m.store.SetMetadata(ctx, key, raw)
+}
+
+func divergenceEvidenceKey(height uint64) string {
+	return DivergenceEvidenceKey + "/" + strconv.FormatUint(height, 10)
+}
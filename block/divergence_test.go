@@ -0,0 +1,134 @@
+package block
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/evidence"
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+func getDivergenceManager(t *testing.T, conf config.BlockManagerConfig) *Manager {
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	eventBus := cmtypes.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() { _ = eventBus.Stop() })
+	return &Manager{
+		conf:         conf,
+		store:        store.New(kv),
+		logger:       test.NewLogger(t),
+		lastStateMtx: new(sync.RWMutex),
+		blockCache:   NewBlockCache(),
+		metrics:      NopMetrics(),
+		eventBus:     eventBus,
+		liveness:     newLivenessMonitor(time.Now()),
+	}
+}
+
+func TestCheckDivergence(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m := getDivergenceManager(t, config.BlockManagerConfig{})
+
+	sub, err := m.eventBus.Subscribe(ctx, "test", cmtypes.QueryForEvent(EventSequencerDivergence))
+	require.NoError(err)
+
+	stored := types.GetRandomBlock(1, 1)
+	require.NoError(m.store.SaveBlock(ctx, stored, &stored.SignedHeader.Commit))
+	m.store.SetHeight(ctx, 1)
+	cached := types.GetRandomBlock(2, 1)
+	m.blockCache.setBlock(2, cached)
+
+	// same blocks are not a divergence
+	require.NoError(m.checkDivergence(ctx, stored))
+	require.NoError(m.checkDivergence(ctx, cached))
+	require.NoError(m.checkDivergence(ctx, types.GetRandomBlock(3, 1)))
+	require.False(m.IsSequencerFaulty())
+
+	conflicting := types.GetRandomBlock(1, 1)
+	require.ErrorIs(m.checkDivergence(ctx, conflicting), ErrSequencerDivergence)
+	require.True(m.IsSequencerFaulty())
+
+	evidence, err := m.GetDivergenceEvidence(ctx, 1)
+	require.NoError(err)
+	assert.Equal(t, stored.Hash(), evidence.FirstHash)
+	assert.Equal(t, conflicting.Hash(), evidence.SecondHash)
+	var second types.Block
+	require.NoError(second.UnmarshalBinary(evidence.Second))
+	assert.Equal(t, conflicting.Hash(), second.Hash())
+
+	select {
+	case msg := <-sub.Out():
+		data, ok := msg.Data().(EventDataSequencerDivergence)
+		require.True(ok)
+		assert.Equal(t, uint64(1), data.Height)
+	case <-time.After(time.Second):
+		t.Fatal("divergence event not published")
+	}
+
+	require.ErrorIs(m.checkDivergence(ctx, types.GetRandomBlock(2, 1)), ErrSequencerDivergence)
+	_, err = m.GetDivergenceEvidence(ctx, 2)
+	require.NoError(err)
+	require.Nil(m.HaltReport())
+}
+
+func TestCheckDivergenceHalt(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m := getDivergenceManager(t, config.BlockManagerConfig{HaltOnDivergence: true})
+
+	first := types.GetRandomBlock(1, 1)
+	m.blockCache.setBlock(1, first)
+	err := m.checkDivergence(ctx, types.GetRandomBlock(1, 1))
+	require.ErrorIs(err, ErrHalted)
+	require.ErrorIs(err, ErrSequencerDivergence)
+	require.NotNil(m.HaltReport())
+}
+
+func TestCheckDivergenceEvidence(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m := getDivergenceManager(t, config.BlockManagerConfig{})
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	evpool, err := evidence.NewPool(ctx, kv, test.NewLogger(t))
+	require.NoError(err)
+	m.evpool = evpool
+
+	first, privKey := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1})
+	second, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1, PrivKey: privKey})
+	m.blockCache.setBlock(1, first)
+	require.ErrorIs(m.checkDivergence(ctx, second), ErrSequencerDivergence)
+
+	pending, _ := evpool.PendingEvidence(1 << 20)
+	require.Len(pending, 1)
+	require.NoError(types.VerifyEvidence(pending[0], types.TestChainID, first.SignedHeader.Validators))
+}
+
+func TestMarkDAIncludedConflicting(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m := getDivergenceManager(t, config.BlockManagerConfig{})
+
+	cached := types.GetRandomBlock(1, 1)
+	m.blockCache.setBlock(1, cached)
+
+	conflicting := types.GetRandomBlock(1, 1)
+	require.ErrorIs(m.markDAIncluded(ctx, conflicting, 10, time.Time{}), ErrSequencerDivergence)
+	require.False(m.blockCache.isDAIncluded(conflicting.Hash().String()))
+	require.False(m.blockCache.isDAIncluded(cached.Hash().String()))
+	require.True(m.IsSequencerFaulty())
+
+	require.NoError(m.markDAIncluded(ctx, cached, 10, time.Time{}))
+	require.True(m.blockCache.isDAIncluded(cached.Hash().String()))
+}
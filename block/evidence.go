@@ -0,0 +1,62 @@
+package block
+
+import (
+	"context"
+	"errors"
+
+	cmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/rollkit/rollkit/state"
+	"github.com/rollkit/rollkit/types"
+)
+
+// ErrNoEvidencePool is returned when evidence is submitted, but evidence pool is not set.
+var ErrNoEvidencePool = errors.New("evidence pool is not set")
+
+// EvidencePool keeps evidence of misbehavior until it's included in a block.
+type EvidencePool interface {
+	state.EvidencePool
+	// AddEvidence adds verified evidence to the pool.
+	AddEvidence(ctx context.Context, ev cmtypes.Evidence) error
+}
+
+// SetEvidencePool is used to set evidence pool, used to include evidence in produced blocks, and to report evidence of
+// sequencer divergence.
+//
+// Evidence expiry is relative to the last committed block, so the pool is updated with the last state.
+func (m *Manager) SetEvidencePool(evpool EvidencePool) {
+	m.evpool = evpool
+	m.executor.SetEvidencePool(evpool)
+	m.lastStateMtx.RLock()
+	lastState := m.lastState
+	m.lastStateMtx.RUnlock()
+	if err := evpool.Update(lastState, nil); err != nil {
+		m.logger.Error("failed to update evidence pool", "error", err)
+	}
+}
+
+// AddEvidence verifies evidence against the sequencer set and adds it to the evidence pool.
+func (m *Manager) AddEvidence(ctx context.Context, ev cmtypes.Evidence) error {
+	if m.evpool == nil {
+		return ErrNoEvidencePool
+	}
+	if err := m.VerifyEvidence(ev); err != nil {
+		return err
+	}
+	return m.evpool.AddEvidence(ctx, ev)
+}
+
+// VerifyEvidence verifies evidence against the sequencer set.
+func (m *Manager) VerifyEvidence(ev cmtypes.Evidence) error {
+	return types.VerifyEvidence(ev, m.genesis.ChainID, m.validatorSet)
+}
+
+// addDuplicateVoteEvidence adds conflicting blocks to the evidence pool as DuplicateVoteEvidence, so the sequencer can
+// be punished by the application.
+func (m *Manager) addDuplicateVoteEvidence(ctx context.Context, first, second *types.Block) error {
+	ev, err := types.NewDuplicateVoteEvidence(&first.SignedHeader, &second.SignedHeader)
+	if err != nil {
+		return err
+	}
+	return m.evpool.AddEvidence(ctx, ev)
+}
@@ -0,0 +1,102 @@
+package block
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// HaltReportKey is the key used for persisting the crash report of a halted node.
+const HaltReportKey = "halt report"
+
+// ErrHalted is returned by block production and syncing after the node halted.
+var ErrHalted = errors.New("node halted")
+
+// HaltReport describes the failure that caused the node to halt.
+type HaltReport struct {
+	// Height of the block that caused the halt.
+	Height uint64 `json:"height"`
+	// BlockHash is the hash of the block that caused the halt.
+	BlockHash types.Hash `json:"block_hash"`
+	// Error describes the failure, e.g. error returned by the ABCI application.
+	Error string `json:"error"`
+	// Time when node halted.
+	Time time.Time `json:"time"`
+	// Block is the binary encoded block that caused the halt.
+	Block []byte `json:"block,omitempty"`
+	// State is the binary encoded last state of the node.
+	State []byte `json:"state,omitempty"`
+}
+
+// HaltReport returns the crash report if the node halted, or nil otherwise.
+func (m *Manager) HaltReport() *HaltReport {
+	return m.haltReport.Load()
+}
+
+// LastHaltReport returns the crash report of the current halt, or the last persisted one if the node was restarted
+// since it halted. Nil is returned if the node never halted.
+func (m *Manager) LastHaltReport(ctx context.Context) (*HaltReport, error) {
+	if report := m.HaltReport(); report != nil {
+		return report, nil
+	}
+	raw, err := m.store.GetMetadata(ctx, HaltReportKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var report HaltReport
+	if err := json.Unmarshal(raw, &report); err != nil {
+		return nil, fmt.Errorf("failed to decode halt report: %w", err)
+	}
+	return &report, nil
+}
+
+func (m *Manager) isHalted() bool {
+	return m.haltReport.Load() != nil
+}
+
+// halt stops block production and syncing after block couldn't be safely processed.
+//
+// Instead of crashing the process, a crash report is persisted in the store, so the RPC server can keep serving
+// queries and operators can investigate the failure. Halt is not persisted: after restart, node retries the block,
+// but the report remains available via LastHaltReport.
+// See https://github.com/cometbft/cometbft/pull/496 for why node can't continue.
+func (m *Manager) halt(ctx context.Context, block *types.Block, cause error) error {
+	report := &HaltReport{
+		Height:    block.Height(),
+		BlockHash: block.Hash(),
+		Error:     cause.Error(),
+		Time:      time.Now(),
+	}
+	if blob, err := block.MarshalBinary(); err == nil {
+		report.Block = blob
+	}
+	m.lastStateMtx.RLock()
+	if pState, err := m.lastState.ToProto(); err == nil {
+		if blob, err := pState.Marshal(); err == nil {
+			report.State = blob
+		}
+	}
+	m.lastStateMtx.RUnlock()
+
+	if !m.haltReport.CompareAndSwap(nil, report) {
+		return ErrHalted
+	}
+	m.logger.Error("halting node", "height", report.Height, "hash", report.BlockHash, "error", cause)
+
+	raw, err := json.Marshal(report)
+	if err != nil {
+		m.logger.Error("failed to encode halt report", "error", err)
+	} else if err := m.store.SetMetadata(ctx, HaltReportKey, raw); err != nil {
+		m.logger.Error("failed to save halt report", "error", err)
+	}
+	return fmt.Errorf("%w at height %d: %w", ErrHalted, report.Height, cause)
+}
@@ -0,0 +1,75 @@
+package block
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestHalt(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	m := &Manager{
+		store:        store.New(kv),
+		logger:       test.NewLogger(t),
+		lastStateMtx: new(sync.RWMutex),
+		blockCache:   NewBlockCache(),
+		isProposer:   true,
+		lastState:    types.State{ChainID: "test", LastBlockHeight: 4},
+	}
+	require.Nil(m.HaltReport())
+	last, err := m.LastHaltReport(ctx)
+	require.NoError(err)
+	require.Nil(last)
+
+	block := types.GetRandomBlock(5, 1)
+	cause := errors.New("app failure")
+	err = m.halt(ctx, block, cause)
+	require.ErrorIs(err, ErrHalted)
+	require.ErrorIs(err, cause)
+
+	report := m.HaltReport()
+	require.NotNil(report)
+	assert.Equal(t, uint64(5), report.Height)
+	assert.Equal(t, block.Hash(), report.BlockHash)
+	assert.Equal(t, cause.Error(), report.Error)
+
+	var haltedBlock types.Block
+	require.NoError(haltedBlock.UnmarshalBinary(report.Block))
+	assert.Equal(t, block.Hash(), haltedBlock.Hash())
+
+	raw, err := m.store.GetMetadata(ctx, HaltReportKey)
+	require.NoError(err)
+	var stored HaltReport
+	require.NoError(json.Unmarshal(raw, &stored))
+	assert.Equal(t, report.Height, stored.Height)
+	assert.Equal(t, report.Error, stored.Error)
+
+	// subsequent failures don't overwrite the original report
+	require.ErrorIs(m.halt(ctx, types.GetRandomBlock(6, 1), errors.New("other failure")), ErrHalted)
+	assert.Equal(t, report, m.HaltReport())
+
+	require.ErrorIs(m.publishBlock(ctx), ErrHalted)
+	require.ErrorIs(m.trySyncNextBlock(ctx, 0), ErrHalted)
+
+	// after restart, node is not halted, but the last report is still available
+	restarted := &Manager{store: m.store}
+	require.Nil(restarted.HaltReport())
+	last, err = restarted.LastHaltReport(ctx)
+	require.NoError(err)
+	require.NotNil(last)
+	assert.Equal(t, report.Height, last.Height)
+	assert.Equal(t, report.Block, last.Block)
+}
@@ -0,0 +1,302 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// defaultLeaseTTL is used when LeaseTTL is not specified in the config
+const defaultLeaseTTL = 10 * time.Second
+
+var (
+	// ErrLeaseHeld is returned when aggregator lease is held by another node.
+	ErrLeaseHeld = errors.New("lease held by another node")
+
+	// ErrLeaseBehind is returned when another node published blocks that are not synced yet.
+	ErrLeaseBehind = errors.New("blocks published by previous lease holder are not synced")
+
+	// ErrLeaseReleased is returned when block is produced after the lease was released.
+	ErrLeaseReleased = errors.New("lease released")
+
+	// ErrLeaseLost is returned when block is produced after the lease was lost, until it's acquired again.
+	ErrLeaseLost = errors.New("lease lost")
+)
+
+// Lease is held by the active aggregator in hot-standby mode.
+//
+// Before signing a block, aggregator reserves its height in the lease, and before the block is passed for publication,
+// its height is recorded as published. Other node can take over only after the lease expires, and starts producing
+// blocks after it synced all published heights, so no published height is ever signed twice. Heights that were
+// reserved, but not published (e.g. because holder crashed) are signed again by the new holder.
+type Lease struct {
+	// Holder identifies the node holding the lease.
+	Holder string `json:"holder"`
+	// Expiry is the time after which lease can be taken over.
+	Expiry time.Time `json:"expiry"`
+	// Height is the highest height reserved for signing by any holder.
+	Height uint64 `json:"height"`
+	// ReservedBy identifies the node that reserved Height.
+	ReservedBy string `json:"reserved_by"`
+	// PublishedHeight is the height of the last block passed for publication by any holder.
+	PublishedHeight uint64 `json:"published_height"`
+	// SubmittedHeight is the height of the last block submitted to DA, reported by the holder.
+	SubmittedHeight uint64 `json:"submitted_height"`
+	// Revision is incremented on every update of the lease.
+	Revision uint64 `json:"revision"`
+}
+
+// synced returns true if node with given height of the last block can sign the next block. All published heights have
+// to be synced, unless the next height was reserved and published by the node itself. Block signed by the node is
+// published only after it's saved in the store, so it can be safely re-created or reused after restart.
+func (l *Lease) synced(holder string, height uint64) bool {
+	return l.PublishedHeight <= height || (l.ReservedBy == holder && l.Height == height+1 && l.PublishedHeight == height+1)
+}
+
+// LeaseBackend stores the aggregator lease, shared by all aggregators of the chain.
+type LeaseBackend interface {
+	// Load returns the current lease, or nil if lease was never acquired.
+	Load(ctx context.Context) (*Lease, error)
+	// CompareAndSwap atomically replaces the lease with new one, only if current lease has the same revision as old
+	// (or doesn't exist, if old is nil). It returns false if lease was changed in the meantime.
+	CompareAndSwap(ctx context.Context, old, new *Lease) (bool, error)
+}
+
+// leaseKeeper acquires and renews the lease on behalf of the manager.
+type leaseKeeper struct {
+	backend LeaseBackend
+	holder  string
+	ttl     time.Duration
+
+	// mtx is held during block production, released is set when node stops, lost is set when lease is lost
+	mtx      sync.Mutex
+	released bool
+	lost     bool
+}
+
+// update atomically applies f to the current lease, retrying if lease was changed concurrently.
+func (l *leaseKeeper) update(ctx context.Context, f func(cur *Lease) (*Lease, error)) (*Lease, error) {
+	for {
+		cur, err := l.backend.Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		next, err := f(cur)
+		if err != nil {
+			return cur, err
+		}
+		if cur != nil {
+			next.Revision = cur.Revision + 1
+		}
+		ok, err := l.backend.CompareAndSwap(ctx, cur, next)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return next, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// acquire acquires or renews the lease.
+//
+// Lease can be acquired if it's free, expired or already held by this node. Expired lease of another node is not
+// acquired if published heights are not synced, unless node is taking over from standby mode.
+func (l *leaseKeeper) acquire(ctx context.Context, syncedHeight, submittedHeight uint64, takeover bool) (*Lease, error) {
+	return l.update(ctx, func(cur *Lease) (*Lease, error) {
+		next := &Lease{Holder: l.holder, Expiry: time.Now().Add(l.ttl), SubmittedHeight: submittedHeight}
+		if cur == nil {
+			return next, nil
+		}
+		if cur.Holder != l.holder {
+			if time.Now().Before(cur.Expiry) {
+				return nil, fmt.Errorf("%w: %s until %s", ErrLeaseHeld, cur.Holder, cur.Expiry)
+			}
+			if !takeover && !cur.synced(l.holder, syncedHeight) {
+				return nil, fmt.Errorf("%w: published %d, synced %d", ErrLeaseBehind, cur.PublishedHeight, syncedHeight)
+			}
+		}
+		next.Height = cur.Height
+		next.ReservedBy = cur.ReservedBy
+		next.PublishedHeight = cur.PublishedHeight
+		next.SubmittedHeight = max(cur.SubmittedHeight, submittedHeight)
+		return next, nil
+	})
+}
+
+// reserve renews the lease and reserves given height for signing.
+//
+// Height can be reserved only if all published heights were synced, or the height was already reserved and published
+// by this node.
+func (l *leaseKeeper) reserve(ctx context.Context, height uint64) (*Lease, error) {
+	return l.update(ctx, func(cur *Lease) (*Lease, error) {
+		next := &Lease{Holder: l.holder, Expiry: time.Now().Add(l.ttl), Height: height, ReservedBy: l.holder}
+		if cur == nil {
+			return next, nil
+		}
+		if cur.Holder != l.holder && time.Now().Before(cur.Expiry) {
+			return nil, fmt.Errorf("%w: %s until %s", ErrLeaseHeld, cur.Holder, cur.Expiry)
+		}
+		if !cur.synced(l.holder, height-1) {
+			return nil, fmt.Errorf("%w: published %d, synced %d", ErrLeaseBehind, cur.PublishedHeight, height-1)
+		}
+		next.SubmittedHeight = cur.SubmittedHeight
+		next.PublishedHeight = cur.PublishedHeight
+		return next, nil
+	})
+}
+
+// publish records given height as published. It fails if lease is not held by this node anymore, so block is never
+// published after another node could take over.
+func (l *leaseKeeper) publish(ctx context.Context, height uint64) (*Lease, error) {
+	return l.update(ctx, func(cur *Lease) (*Lease, error) {
+		if cur == nil || cur.Holder != l.holder {
+			return nil, ErrLeaseHeld
+		}
+		next := *cur
+		next.Expiry = time.Now().Add(l.ttl)
+		next.PublishedHeight = max(cur.PublishedHeight, height)
+		return &next, nil
+	})
+}
+
+// SetLease enables hot-standby mode, where block production requires holding the lease stored in the backend.
+//
+// Holder must uniquely identify the node. It has to be called before the node is started.
+func (m *Manager) SetLease(backend LeaseBackend, holder string) {
+	m.lease = &leaseKeeper{backend: backend, holder: holder, ttl: m.conf.LeaseTTL}
+	m.standby.Store(true)
+}
+
+// WaitForLease blocks until this node acquires the lease and syncs all blocks published by the previous holder.
+//
+// Heights reserved by the previous holder, but not published (e.g. because it crashed while producing a block), are
+// not waited for.
+//
+// Block syncing has to be running while waiting. Once this method returns, syncing should be stopped and block
+// production started.
+func (m *Manager) WaitForLease(ctx context.Context) error {
+	ticker := time.NewTicker(m.conf.LeaseTTL / 3)
+	defer ticker.Stop()
+	for {
+		lease, err := m.lease.acquire(ctx, m.store.Height(), 0, true)
+		switch {
+		case err == nil && lease.synced(m.lease.holder, m.store.Height()):
+			m.logger.Info("acquired aggregator lease", "height", m.store.Height(), "submittedHeight", lease.SubmittedHeight)
+			m.pendingBlocks.setLastSubmittedHeight(ctx, lease.SubmittedHeight)
+			m.lease.mtx.Lock()
+			m.lease.lost = false
+			m.lease.mtx.Unlock()
+			m.standby.Store(false)
+			return nil
+		case err == nil:
+			m.logger.Info("acquired aggregator lease, waiting for blocks published by previous holder", "height", m.store.Height(), "publishedHeight", lease.PublishedHeight)
+		case errors.Is(err, ErrLeaseHeld):
+			m.logger.Debug("aggregator lease not acquired", "error", err)
+		case ctx.Err() == nil:
+			m.logger.Error("failed to acquire aggregator lease", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// LeaseLoop periodically renews the lease held by the active aggregator.
+//
+// It returns when the lease is lost (and the manager is back in standby mode) or context is cancelled. Once it
+// returns, block production should be stopped and syncing started again.
+func (m *Manager) LeaseLoop(ctx context.Context) error {
+	ticker := time.NewTicker(m.conf.LeaseTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		_, err := m.lease.acquire(ctx, m.store.Height(), m.pendingBlocks.lastSubmittedHeight.Load(), false)
+		if errors.Is(err, ErrLeaseHeld) || errors.Is(err, ErrLeaseBehind) {
+			// wait for the block being produced, so it's published before block production is stopped
+			m.lease.mtx.Lock()
+			m.lease.lost = true
+			m.lease.mtx.Unlock()
+			m.standby.Store(true)
+			return fmt.Errorf("aggregator lease lost: %w", err)
+		} else if err != nil && ctx.Err() == nil {
+			m.logger.Error("failed to renew aggregator lease", "error", err)
+		}
+	}
+}
+
+// ReleaseLease stops block production and releases the lease held by this node, so another node can take over
+// immediately.
+//
+// Block that was signed and saved, but not published, is not published anymore; another node signs its height again,
+// and the block is replaced in the store when this node syncs the height.
+func (m *Manager) ReleaseLease(ctx context.Context) error {
+	if m.lease == nil {
+		return nil
+	}
+	m.lease.mtx.Lock()
+	defer m.lease.mtx.Unlock()
+	m.lease.released = true
+
+	_, err := m.lease.update(ctx, func(cur *Lease) (*Lease, error) {
+		if cur == nil || cur.Holder != m.lease.holder {
+			return nil, ErrLeaseHeld
+		}
+		next := *cur
+		next.Expiry = time.Now()
+		return &next, nil
+	})
+	if errors.Is(err, ErrLeaseHeld) {
+		return nil
+	}
+	return err
+}
+
+// reserveHeight reserves height of the block before it's signed. It's a no-op if hot-standby mode is not enabled.
+//
+// Returned function has to be called after the block is passed for publication, or block production failed. Before the
+// block is passed for publication, its height has to be recorded with markPublished.
+func (m *Manager) reserveHeight(ctx context.Context, height uint64) (func(), error) {
+	if m.lease == nil {
+		return func() {}, nil
+	}
+	m.lease.mtx.Lock()
+	if m.lease.released {
+		m.lease.mtx.Unlock()
+		return nil, ErrLeaseReleased
+	}
+	if m.lease.lost {
+		m.lease.mtx.Unlock()
+		return nil, ErrLeaseLost
+	}
+	if _, err := m.lease.reserve(ctx, height); err != nil {
+		m.lease.mtx.Unlock()
+		return nil, fmt.Errorf("failed to reserve height %d: %w", height, err)
+	}
+	return m.lease.mtx.Unlock, nil
+}
+
+// markPublished records height of the block about to be passed for publication. It's a no-op if hot-standby mode is
+// not enabled.
+//
+// It has to be called while holding the reservation returned by reserveHeight.
+func (m *Manager) markPublished(ctx context.Context, height uint64) error {
+	if m.lease == nil {
+		return nil
+	}
+	if _, err := m.lease.publish(ctx, height); err != nil {
+		return fmt.Errorf("failed to record height %d as published: %w", height, err)
+	}
+	return nil
+}
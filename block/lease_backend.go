@@ -0,0 +1,264 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+)
+
+// leaseKey is the key used for storing the lease in KVLeaseBackend.
+var leaseKey = ds.NewKey("lease")
+
+// staleLockTimeout defines after how long lock of FileLeaseBackend is considered abandoned by a crashed node.
+const staleLockTimeout = 30 * time.Second
+
+// errLeaseLockLost is returned when lock of FileLeaseBackend was broken by another node before lease was updated.
+var errLeaseLockLost = errors.New("lease lock lost")
+
+// FileLeaseBackend stores the lease in a file, e.g. on a filesystem shared by aggregators.
+//
+// Updates are serialized with a lock file created next to the lease file, lease is replaced atomically by renaming a
+// temporary file. Lock abandoned by a crashed node is broken after staleLockTimeout.
+type FileLeaseBackend struct {
+	path string
+}
+
+var _ LeaseBackend = &FileLeaseBackend{}
+
+// NewFileLeaseBackend creates a lease backend using file with given path.
+func NewFileLeaseBackend(path string) *FileLeaseBackend {
+	return &FileLeaseBackend{path: path}
+}
+
+// Load returns the lease stored in the file.
+func (f *FileLeaseBackend) Load(_ context.Context) (*Lease, error) {
+	raw, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	lease := new(Lease)
+	if err := json.Unmarshal(raw, lease); err != nil {
+		return nil, fmt.Errorf("failed to decode lease file %s: %w", f.path, err)
+	}
+	return lease, nil
+}
+
+// CompareAndSwap replaces the lease stored in the file, if it wasn't changed since old was loaded.
+func (f *FileLeaseBackend) CompareAndSwap(ctx context.Context, old, new *Lease) (bool, error) {
+	token, err := f.lock(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer f.unlock(token)
+
+	cur, err := f.Load(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !sameRevision(cur, old) {
+		return false, nil
+	}
+
+	raw, err := json.Marshal(new)
+	if err != nil {
+		return false, err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".tmp")
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(raw); err != nil {
+		_ = tmp.Close()
+		return false, err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return false, err
+	}
+	if err := tmp.Close(); err != nil {
+		return false, err
+	}
+	if err := f.checkLock(token); err != nil {
+		return false, err
+	}
+	if err := os.Rename(tmp.Name(), f.path); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// lock creates the lock file, waiting until it's released by other nodes.
+//
+// Lock file contains a random token identifying the owner, so lock is released only by its owner, and stale lock is
+// broken only if it wasn't replaced by a fresh one in the meantime.
+func (f *FileLeaseBackend) lock(ctx context.Context) ([]byte, error) {
+	lockPath := f.lockPath()
+	token, err := newLockToken()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		file, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			_, err = file.Write(token)
+			if cerr := file.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				_ = os.Remove(lockPath)
+				return nil, err
+			}
+			return token, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+		// content is read before checking modification time, so it can't belong to a lock created afterwards
+		if stale, err := os.ReadFile(lockPath); err == nil && len(stale) > 0 {
+			if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > staleLockTimeout {
+				if err := breakStaleLock(lockPath, stale, token); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// unlock removes the lock file, if it's still owned by given token.
+func (f *FileLeaseBackend) unlock(token []byte) {
+	if f.checkLock(token) == nil {
+		_ = os.Remove(f.lockPath())
+	}
+}
+
+// checkLock returns error if lock file is not owned by given token anymore.
+func (f *FileLeaseBackend) checkLock(token []byte) error {
+	cur, err := os.ReadFile(f.lockPath())
+	if err != nil {
+		return fmt.Errorf("%w: %w", errLeaseLockLost, err)
+	}
+	if !bytes.Equal(cur, token) {
+		return errLeaseLockLost
+	}
+	return nil
+}
+
+// breakStaleLock atomically moves the stale lock file away, by renaming it to a name unique for this node. If lock was
+// replaced by a fresh one after it was read (e.g. because other node broke it concurrently), the fresh lock is put
+// back, unless another lock was created in the meantime.
+func breakStaleLock(lockPath string, stale, token []byte) error {
+	moved := fmt.Sprintf("%s.stale.%x", lockPath, token)
+	if err := os.Rename(lockPath, moved); errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(moved) }()
+	cur, err := os.ReadFile(moved)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(cur, stale) {
+		return nil
+	}
+	if err := os.Link(moved, lockPath); err != nil && !errors.Is(err, os.ErrExist) {
+		return err
+	}
+	return nil
+}
+
+func (f *FileLeaseBackend) lockPath() string {
+	return f.path + ".lock"
+}
+
+func newLockToken() ([]byte, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	return []byte(hex.EncodeToString(buf)), nil
+}
+
+// KVLeaseBackend stores the lease in a key-value store.
+//
+// Updates are serialized only within the process, so it's intended for testing, with multiple nodes sharing the same
+// backend.
+type KVLeaseBackend struct {
+	mtx sync.Mutex
+	kv  ds.Datastore
+}
+
+var _ LeaseBackend = &KVLeaseBackend{}
+
+// NewKVLeaseBackend creates a lease backend using given key-value store.
+func NewKVLeaseBackend(kv ds.Datastore) *KVLeaseBackend {
+	return &KVLeaseBackend{kv: kv}
+}
+
+// Load returns the lease stored in the key-value store.
+func (b *KVLeaseBackend) Load(ctx context.Context) (*Lease, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.load(ctx)
+}
+
+func (b *KVLeaseBackend) load(ctx context.Context) (*Lease, error) {
+	raw, err := b.kv.Get(ctx, leaseKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	lease := new(Lease)
+	if err := json.Unmarshal(raw, lease); err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// CompareAndSwap replaces the lease stored in the key-value store, if it wasn't changed since old was loaded.
+func (b *KVLeaseBackend) CompareAndSwap(ctx context.Context, old, new *Lease) (bool, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	cur, err := b.load(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !sameRevision(cur, old) {
+		return false, nil
+	}
+	raw, err := json.Marshal(new)
+	if err != nil {
+		return false, err
+	}
+	return true, b.kv.Put(ctx, leaseKey, raw)
+}
+
+func sameRevision(cur, old *Lease) bool {
+	if cur == nil || old == nil {
+		return cur == nil && old == nil
+	}
+	return cur.Revision == old.Revision
+}
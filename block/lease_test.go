@@ -0,0 +1,389 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestLeaseBackends(t *testing.T) {
+	backends := map[string]LeaseBackend{
+		"file": NewFileLeaseBackend(filepath.Join(t.TempDir(), "lease.json")),
+		"kv":   NewKVLeaseBackend(ds.NewMapDatastore()),
+	}
+	for name, backend := range backends {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := context.Background()
+
+			lease, err := backend.Load(ctx)
+			require.NoError(err)
+			require.Nil(lease)
+
+			first := &Lease{Holder: "a", Expiry: time.Now().Add(time.Minute).UTC(), Height: 1}
+			ok, err := backend.CompareAndSwap(ctx, nil, first)
+			require.NoError(err)
+			require.True(ok)
+
+			// lease was already created
+			ok, err = backend.CompareAndSwap(ctx, nil, &Lease{Holder: "b"})
+			require.NoError(err)
+			require.False(ok)
+
+			lease, err = backend.Load(ctx)
+			require.NoError(err)
+			require.Equal(first, lease)
+
+			second := &Lease{Holder: "b", Height: 2, Revision: 1}
+			ok, err = backend.CompareAndSwap(ctx, lease, second)
+			require.NoError(err)
+			require.True(ok)
+
+			// stale revision
+			ok, err = backend.CompareAndSwap(ctx, lease, &Lease{Holder: "c", Revision: 1})
+			require.NoError(err)
+			require.False(ok)
+
+			lease, err = backend.Load(ctx)
+			require.NoError(err)
+			require.Equal("b", lease.Holder)
+		})
+	}
+}
+
+func TestLeaseReservations(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	backend := NewKVLeaseBackend(ds.NewMapDatastore())
+	ttl := 100 * time.Millisecond
+	a := &leaseKeeper{backend: backend, holder: "a", ttl: ttl}
+	b := &leaseKeeper{backend: backend, holder: "b", ttl: ttl}
+
+	for height := uint64(1); height <= 3; height++ {
+		_, err := a.reserve(ctx, height)
+		require.NoError(err)
+		_, err = a.publish(ctx, height)
+		require.NoError(err)
+	}
+	// retrying reservation of the same height is allowed
+	_, err := a.reserve(ctx, 3)
+	require.NoError(err)
+	// a reserves height 4, but crashes before publishing the block
+	_, err = a.reserve(ctx, 4)
+	require.NoError(err)
+
+	_, err = b.acquire(ctx, 3, 0, true)
+	require.ErrorIs(err, ErrLeaseHeld)
+	_, err = b.reserve(ctx, 4)
+	require.ErrorIs(err, ErrLeaseHeld)
+	_, err = b.publish(ctx, 4)
+	require.ErrorIs(err, ErrLeaseHeld)
+
+	time.Sleep(ttl)
+
+	// b takes over, but it can't sign blocks published by a
+	lease, err := b.acquire(ctx, 2, 0, true)
+	require.NoError(err)
+	assert.Equal(t, "b", lease.Holder)
+	assert.Equal(t, uint64(3), lease.PublishedHeight)
+	assert.False(t, lease.synced("b", 2))
+	_, err = b.reserve(ctx, 3)
+	require.ErrorIs(err, ErrLeaseBehind)
+	// height reserved by a, but not published, can be signed by b
+	assert.True(t, lease.synced("b", 3))
+	_, err = b.reserve(ctx, 4)
+	require.NoError(err)
+	_, err = b.publish(ctx, 4)
+	require.NoError(err)
+
+	// a lost the lease
+	_, err = a.acquire(ctx, 3, 0, false)
+	require.ErrorIs(err, ErrLeaseHeld)
+	_, err = a.reserve(ctx, 4)
+	require.ErrorIs(err, ErrLeaseHeld)
+	_, err = a.publish(ctx, 4)
+	require.ErrorIs(err, ErrLeaseHeld)
+
+	time.Sleep(ttl)
+
+	// lease expired, but a is not synced to height published by b
+	_, err = a.acquire(ctx, 3, 0, false)
+	require.ErrorIs(err, ErrLeaseBehind)
+	_, err = a.reserve(ctx, 4)
+	require.ErrorIs(err, ErrLeaseBehind)
+	_, err = a.reserve(ctx, 5)
+	require.NoError(err)
+	_, err = a.publish(ctx, 5)
+	require.NoError(err)
+
+	// after restart, node can resume its own published block, if it wasn't synced yet
+	lease, err = a.acquire(ctx, 4, 0, false)
+	require.NoError(err)
+	assert.True(t, lease.synced("a", 4))
+	assert.False(t, lease.synced("b", 4))
+}
+
+func TestWaitForLease(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := store.New(kv)
+	pendingBlocks, err := NewPendingBlocks(s, test.NewLogger(t))
+	require.NoError(err)
+
+	ttl := 150 * time.Millisecond
+	m := &Manager{
+		conf:          config.BlockManagerConfig{LeaseTTL: ttl, MaxPendingBlocks: 1},
+		isProposer:    true,
+		store:         s,
+		pendingBlocks: pendingBlocks,
+		logger:        test.NewLogger(t),
+		metrics:       NopMetrics(),
+	}
+	backend := NewKVLeaseBackend(ds.NewMapDatastore())
+	m.SetLease(backend, "standby")
+	require.NoError(m.CheckBackPressure())
+	_, err = m.PreConfirm(make([]byte, 32))
+	require.ErrorIs(err, ErrNotProposer)
+
+	active := &leaseKeeper{backend: backend, holder: "active", ttl: ttl}
+	_, err = active.reserve(ctx, 1)
+	require.NoError(err)
+	_, err = active.publish(ctx, 1)
+	require.NoError(err)
+	_, err = active.acquire(ctx, 1, 1, false)
+	require.NoError(err)
+	_, err = active.reserve(ctx, 2)
+	require.NoError(err)
+	_, err = active.publish(ctx, 2)
+	require.NoError(err)
+	// active crashes after reserving height 3, before the block is published
+	_, err = active.reserve(ctx, 3)
+	require.NoError(err)
+	s.SetHeight(ctx, 1)
+
+	done := make(chan error, 1)
+	go func() { done <- m.WaitForLease(ctx) }()
+
+	// lease expires, but standby has to sync block published by previous holder
+	time.Sleep(2 * ttl)
+	select {
+	case err := <-done:
+		t.Fatalf("lease acquired before syncing published blocks: %v", err)
+	default:
+	}
+	lease, err := backend.Load(ctx)
+	require.NoError(err)
+	require.Equal("standby", lease.Holder)
+
+	s.SetHeight(ctx, 2)
+	require.NoError(<-done)
+	require.False(m.standby.Load())
+	require.Equal(uint64(1), pendingBlocks.lastSubmittedHeight.Load())
+	require.Equal(uint64(1), m.DABacklog().PendingBlocks)
+
+	// height reserved, but not published by previous holder is signed by standby
+	unlock, err := m.reserveHeight(ctx, 3)
+	require.NoError(err)
+	unlock()
+	_, err = active.reserve(ctx, 3)
+	require.ErrorIs(err, ErrLeaseHeld)
+
+	// reserved height wasn't published, so it can be signed by another node after the lease is released
+	require.NoError(m.ReleaseLease(ctx))
+	_, err = m.reserveHeight(ctx, 3)
+	require.ErrorIs(err, ErrLeaseReleased)
+	lease, err = active.reserve(ctx, 3)
+	require.NoError(err)
+	require.Equal("active", lease.Holder)
+}
+
+func TestLeaseLost(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := store.New(kv)
+	pendingBlocks, err := NewPendingBlocks(s, test.NewLogger(t))
+	require.NoError(err)
+
+	ttl := 150 * time.Millisecond
+	m := &Manager{
+		conf:          config.BlockManagerConfig{LeaseTTL: ttl},
+		isProposer:    true,
+		store:         s,
+		pendingBlocks: pendingBlocks,
+		logger:        test.NewLogger(t),
+		metrics:       NopMetrics(),
+	}
+	backend := NewKVLeaseBackend(ds.NewMapDatastore())
+	m.SetLease(backend, "active")
+	require.NoError(m.WaitForLease(ctx))
+	unlock, err := m.reserveHeight(ctx, 1)
+	require.NoError(err)
+	unlock()
+
+	done := make(chan error, 1)
+	go func() { done <- m.LeaseLoop(ctx) }()
+
+	// another node takes over the lease, e.g. after it wasn't renewed in time
+	other := &leaseKeeper{backend: backend, holder: "other", ttl: ttl}
+	_, err = other.update(ctx, func(cur *Lease) (*Lease, error) {
+		next := *cur
+		next.Holder = other.holder
+		next.Expiry = time.Now().Add(ttl)
+		return &next, nil
+	})
+	require.NoError(err)
+
+	require.ErrorIs(<-done, ErrLeaseHeld)
+	require.True(m.standby.Load())
+	_, err = m.reserveHeight(ctx, 1)
+	require.ErrorIs(err, ErrLeaseLost)
+
+	// node goes back to standby and takes over again, after the lease expires
+	s.SetHeight(ctx, 1)
+	require.NoError(m.WaitForLease(ctx))
+	require.False(m.standby.Load())
+	unlock, err = m.reserveHeight(ctx, 2)
+	require.NoError(err)
+	unlock()
+}
+
+func TestReleaseLeaseWithPublishedBlock(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := store.New(kv)
+	pendingBlocks, err := NewPendingBlocks(s, test.NewLogger(t))
+	require.NoError(err)
+
+	m := &Manager{
+		conf:          config.BlockManagerConfig{LeaseTTL: time.Minute},
+		isProposer:    true,
+		store:         s,
+		pendingBlocks: pendingBlocks,
+		logger:        test.NewLogger(t),
+		metrics:       NopMetrics(),
+	}
+	backend := NewKVLeaseBackend(ds.NewMapDatastore())
+	m.SetLease(backend, "active")
+	require.NoError(m.WaitForLease(ctx))
+	unlock, err := m.reserveHeight(ctx, 1)
+	require.NoError(err)
+	// block was signed and passed for publication, but node stopped before it was committed
+	block := types.GetRandomBlock(1, 1)
+	require.NoError(s.SaveBlock(ctx, block, &block.SignedHeader.Commit))
+	require.NoError(m.markPublished(ctx, 1))
+	unlock()
+
+	require.NoError(m.ReleaseLease(ctx))
+	lease, err := backend.Load(ctx)
+	require.NoError(err)
+	require.Equal(uint64(1), lease.PublishedHeight)
+	require.Equal("active", lease.ReservedBy)
+
+	// another node can take over, but it can't sign the published block
+	other := &leaseKeeper{backend: backend, holder: "other", ttl: time.Minute}
+	_, err = other.reserve(ctx, 1)
+	require.ErrorIs(err, ErrLeaseBehind)
+
+	// lease is held by another node, so block can't be published anymore
+	_, err = other.acquire(ctx, 1, 0, true)
+	require.NoError(err)
+	require.ErrorIs(m.markPublished(ctx, 2), ErrLeaseHeld)
+}
+
+func TestFileLeaseBackendStaleLock(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	path := filepath.Join(t.TempDir(), "lease.json")
+	lockPath := path + ".lock"
+	staleTime := time.Now().Add(-2 * staleLockTimeout)
+
+	// lock abandoned by a crashed node
+	require.NoError(os.WriteFile(lockPath, []byte("crashed"), 0o600))
+	require.NoError(os.Chtimes(lockPath, staleTime, staleTime))
+
+	// concurrent acquirers break the stale lock, but only one of them holds the lock at a time
+	const acquirers, updates = 2, 20
+	var inside atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < acquirers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			backend := NewFileLeaseBackend(path)
+			keeper := &leaseKeeper{backend: backend, holder: "a", ttl: time.Minute}
+			for j := 0; j < updates; j++ {
+				_, err := keeper.update(ctx, func(cur *Lease) (*Lease, error) {
+					if !inside.CompareAndSwap(0, 1) {
+						return nil, errors.New("lock held concurrently")
+					}
+					defer inside.Store(0)
+					next := &Lease{Holder: keeper.holder}
+					if cur != nil {
+						next.Height = cur.Height + 1
+					}
+					return next, nil
+				})
+				assert.NoError(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	lease, err := NewFileLeaseBackend(path).Load(ctx)
+	require.NoError(err)
+	require.Equal(uint64(acquirers*updates-1), lease.Height)
+	_, err = os.Stat(lockPath)
+	require.ErrorIs(err, os.ErrNotExist)
+}
+
+func TestBreakStaleLock(t *testing.T) {
+	require := require.New(t)
+	lockPath := filepath.Join(t.TempDir(), "lease.json.lock")
+
+	// lock was replaced by a fresh one after it was read as stale, so it's put back
+	require.NoError(os.WriteFile(lockPath, []byte("fresh"), 0o600))
+	require.NoError(breakStaleLock(lockPath, []byte("stale"), []byte("token")))
+	cur, err := os.ReadFile(lockPath)
+	require.NoError(err)
+	require.Equal([]byte("fresh"), cur)
+
+	// lock is still the stale one, so it's removed
+	require.NoError(breakStaleLock(lockPath, []byte("fresh"), []byte("token")))
+	_, err = os.Stat(lockPath)
+	require.ErrorIs(err, os.ErrNotExist)
+
+	// lock was already broken by another node
+	require.NoError(breakStaleLock(lockPath, []byte("fresh"), []byte("token")))
+
+	// no leftovers of moved locks
+	entries, err := os.ReadDir(filepath.Dir(lockPath))
+	require.NoError(err)
+	require.Empty(entries)
+}
@@ -0,0 +1,155 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	cmjson "github.com/cometbft/cometbft/libs/json"
+
+	goheaderstore "github.com/celestiaorg/go-header/store"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// defaultSequencerHeaderTimeout is used only if SequencerHeaderTimeout is not configured for manager
+const defaultSequencerHeaderTimeout = 30 * time.Second
+
+// defaultSequencerDATimeout is used only if SequencerDATimeout is not configured for manager
+const defaultSequencerDATimeout = 10 * time.Minute
+
+const (
+	// EventSequencerStalled is published when sequencer is considered stalled.
+	EventSequencerStalled = "SequencerStalled"
+	// EventSequencerRecovered is published when stalled sequencer resumes producing blocks.
+	EventSequencerRecovered = "SequencerRecovered"
+)
+
+// ErrSequencerStalled is used when sequencer didn't produce blocks within configured thresholds.
+var ErrSequencerStalled = errors.New("sequencer stalled")
+
+func init() {
+	cmjson.RegisterType(EventDataSequencerLiveness{}, "rollkit/event/SequencerLiveness")
+}
+
+// SequencerLiveness describes how recently the sequencer was seen producing blocks.
+type SequencerLiveness struct {
+	// LastHeaderTime is the time when the last new header was received from P2P network.
+	LastHeaderTime time.Time `json:"last_header_time"`
+	// LastDAIncludedTime is the time when the last block was found on DA.
+	LastDAIncludedTime time.Time `json:"last_da_included_time"`
+	// HeaderStalled is true if no new header was received within SequencerHeaderTimeout.
+	HeaderStalled bool `json:"header_stalled"`
+	// DAStalled is true if no block was found on DA within SequencerDATimeout.
+	DAStalled bool `json:"da_stalled"`
+}
+
+// Stalled returns true if sequencer is considered stalled.
+func (l SequencerLiveness) Stalled() bool {
+	return l.HeaderStalled || l.DAStalled
+}
+
+// EventDataSequencerLiveness is published with EventSequencerStalled and EventSequencerRecovered.
+type EventDataSequencerLiveness SequencerLiveness
+
+// livenessMonitor tracks activity of the sequencer.
+//
+// Sequencer is considered alive when monitoring starts.
+type livenessMonitor struct {
+	mtx                sync.RWMutex
+	lastHeaderHeight   uint64
+	lastHeaderTime     time.Time
+	lastDAIncludedTime time.Time
+	stalled            bool
+}
+
+func newLivenessMonitor(now time.Time) *livenessMonitor {
+	return &livenessMonitor{
+		lastHeaderTime:     now,
+		lastDAIncludedTime: now,
+	}
+}
+
+// SetHeaderStore is used to set store of headers received by HeaderSyncService, used to track sequencer liveness.
+func (m *Manager) SetHeaderStore(headerStore *goheaderstore.Store[*types.SignedHeader]) {
+	m.headerStore = headerStore
+}
+
+// SequencerLiveness returns current view of sequencer liveness.
+func (m *Manager) SequencerLiveness() SequencerLiveness {
+	m.liveness.mtx.RLock()
+	defer m.liveness.mtx.RUnlock()
+	return m.sequencerLiveness(time.Now())
+}
+
+func (m *Manager) sequencerLiveness(now time.Time) SequencerLiveness {
+	return SequencerLiveness{
+		LastHeaderTime:     m.liveness.lastHeaderTime,
+		LastDAIncludedTime: m.liveness.lastDAIncludedTime,
+		HeaderStalled:      now.Sub(m.liveness.lastHeaderTime) > m.conf.SequencerHeaderTimeout,
+		DAStalled:          now.Sub(m.liveness.lastDAIncludedTime) > m.conf.SequencerDATimeout,
+	}
+}
+
+// setDAIncluded records that the sequencer block was found on DA.
+func (m *Manager) setDAIncluded(now time.Time) {
+	m.liveness.mtx.Lock()
+	defer m.liveness.mtx.Unlock()
+	m.liveness.lastDAIncludedTime = now
+}
+
+// LivenessLoop is responsible for monitoring the sequencer on full nodes.
+func (m *Manager) LivenessLoop(ctx context.Context) {
+	ticker := time.NewTicker(m.conf.BlockTime)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		var headerHeight uint64
+		if m.headerStore != nil {
+			headerHeight = m.headerStore.Height()
+		}
+		m.checkLiveness(headerHeight, time.Now())
+	}
+}
+
+// checkLiveness updates sequencer liveness, metrics, and publishes events on stall and recovery.
+func (m *Manager) checkLiveness(headerHeight uint64, now time.Time) {
+	m.liveness.mtx.Lock()
+	if headerHeight > m.liveness.lastHeaderHeight {
+		m.liveness.lastHeaderHeight = headerHeight
+		m.liveness.lastHeaderTime = now
+	}
+	liveness := m.sequencerLiveness(now)
+	changed := liveness.Stalled() != m.liveness.stalled
+	m.liveness.stalled = liveness.Stalled()
+	m.liveness.mtx.Unlock()
+
+	m.metrics.TimeSinceLastHeader.Set(now.Sub(liveness.LastHeaderTime).Seconds())
+	m.metrics.TimeSinceLastDAIncluded.Set(now.Sub(liveness.LastDAIncludedTime).Seconds())
+	if liveness.Stalled() {
+		m.metrics.SequencerStalled.Set(1)
+	} else {
+		m.metrics.SequencerStalled.Set(0)
+	}
+
+	if !changed {
+		return
+	}
+	event := EventSequencerRecovered
+	if liveness.Stalled() {
+		event = EventSequencerStalled
+		m.logger.Error("sequencer stalled", "lastHeaderTime", liveness.LastHeaderTime, "lastDAIncludedTime", liveness.LastDAIncludedTime)
+	} else {
+		m.logger.Info("sequencer recovered")
+	}
+	if m.eventBus != nil {
+		if err := m.eventBus.Publish(event, EventDataSequencerLiveness(liveness)); err != nil {
+			m.logger.Error("failed to publish sequencer liveness event", "error", err)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package block
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	test "github.com/rollkit/rollkit/test/log"
+)
+
+func TestCheckLiveness(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	eventBus := cmtypes.NewEventBus()
+	require.NoError(eventBus.Start())
+	defer func() { _ = eventBus.Stop() }()
+	sub, err := eventBus.Subscribe(ctx, "test", cmtypes.QueryForEvent(EventSequencerStalled))
+	require.NoError(err)
+	recoveredSub, err := eventBus.Subscribe(ctx, "test-recovered", cmtypes.QueryForEvent(EventSequencerRecovered))
+	require.NoError(err)
+
+	start := time.Now()
+	m := &Manager{
+		conf: config.BlockManagerConfig{
+			SequencerHeaderTimeout: 10 * time.Second,
+			SequencerDATimeout:     time.Minute,
+		},
+		logger:   test.NewLogger(t),
+		metrics:  NopMetrics(),
+		eventBus: eventBus,
+		liveness: newLivenessMonitor(start),
+	}
+
+	// new headers keep sequencer alive
+	m.checkLiveness(1, start.Add(5*time.Second))
+	m.checkLiveness(2, start.Add(12*time.Second))
+	liveness := m.sequencerLiveness(start.Add(12 * time.Second))
+	assert.False(t, liveness.Stalled())
+	assert.Equal(t, start.Add(12*time.Second), liveness.LastHeaderTime)
+
+	// no new headers
+	m.checkLiveness(2, start.Add(23*time.Second))
+	liveness = m.sequencerLiveness(start.Add(23 * time.Second))
+	assert.True(t, liveness.HeaderStalled)
+	assert.False(t, liveness.DAStalled)
+	select {
+	case msg := <-sub.Out():
+		data, ok := msg.Data().(EventDataSequencerLiveness)
+		require.True(ok)
+		assert.True(t, data.HeaderStalled)
+	case <-time.After(time.Second):
+		t.Fatal("stalled event not published")
+	}
+
+	// sequencer resumes, but nothing was found on DA
+	m.checkLiveness(3, start.Add(61*time.Second))
+	liveness = m.sequencerLiveness(start.Add(61 * time.Second))
+	assert.False(t, liveness.HeaderStalled)
+	assert.True(t, liveness.DAStalled)
+
+	m.setDAIncluded(start.Add(62 * time.Second))
+	m.checkLiveness(4, start.Add(63*time.Second))
+	assert.False(t, m.sequencerLiveness(start.Add(63*time.Second)).Stalled())
+	select {
+	case <-recoveredSub.Out():
+	case <-time.After(time.Second):
+		t.Fatal("recovered event not published")
+	}
+}
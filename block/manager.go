@@ -72,6 +72,9 @@ var (
 type NewBlockEvent struct {
 	Block    *types.Block
 	DAHeight uint64
+	// DATime is the time of DA block including the block; it's zero for blocks retrieved from P2P network, or if DA
+	// layer doesn't provide block times.
+	DATime time.Time
 }
 
 // Manager is responsible for aggregating transactions into blocks.
@@ -116,13 +119,61 @@ type Manager struct {
 	buildingBlock bool
 	txsAvailable  <-chan struct{}
 
+	// For usage by adaptive block time mode
+	mempool            mempool.Mempool
+	maxBlockBytes      uint64
+	effectiveBlockTime time.Duration
+
 	pendingBlocks *PendingBlocks
 
+	// txStatus tracks lifecycle of transactions, may be nil
+	txStatus *mempool.TxStatusTracker
+
+	// optimistic is the block executed in the background in optimistic execution mode; used only by SyncLoop
+	optimistic *optimisticBlock
+
+	// finalHeight is the height of the last block marked as final in the executor
+	finalHeight    uint64
+	finalHeightMtx sync.Mutex
+
 	// for reporting metrics
 	metrics *Metrics
 
 	// true if the manager is a proposer
 	isProposer bool
+
+	// prover generates validity proofs of produced blocks, verifier checks proofs of synced blocks
+	prover   Prover
+	verifier Verifier
+	// proofCh is used to notify ProofLoop that new blocks were committed
+	proofCh chan struct{}
+	// proofInCh is used to notify SyncLoop that new validity proofs were retrieved from DA
+	proofInCh chan struct{}
+	// executedCh is used to notify SyncLoop that execution of block in optimistic execution mode is finished
+	executedCh chan struct{}
+	// proofCache holds validity proofs retrieved from DA, keyed by block height
+	proofCache sync.Map
+
+	// haltReport is set when block couldn't be applied and node halted
+	haltReport atomic.Pointer[HaltReport]
+
+	// sequencerFaulty is set when sequencer published conflicting blocks at the same height
+	sequencerFaulty atomic.Bool
+	// evpool receives evidence of sequencer divergence, may be nil
+	evpool EvidencePool
+
+	eventBus *cmtypes.EventBus
+
+	// headerStore and liveness are used by full nodes to monitor the sequencer
+	headerStore *goheaderstore.Store[*types.SignedHeader]
+	liveness    *livenessMonitor
+
+	// preConfirmations are pending pre-confirmations verified by full nodes against synced blocks
+	preConfirmations pendingPreConfirmations
+
+	// lease is used in hot-standby mode, standby is set until the lease is acquired
+	lease   *leaseKeeper
+	standby atomic.Bool
 }
 
 // getInitialState tries to load lastState from Store, and if it's not available it reads GenesisDoc.
@@ -151,6 +202,8 @@ func getInitialState(store store.Store, genesis *cmtypes.GenesisDoc) (types.Stat
 }
 
 // NewManager creates new block Manager.
+//
+// Blocks are executed by executor, if it's not nil, or by ABCI application using proxyApp otherwise.
 func NewManager(
 	proposerKey crypto.PrivKey,
 	conf config.BlockManagerConfig,
@@ -158,6 +211,7 @@ func NewManager(
 	store store.Store,
 	mempool mempool.Mempool,
 	proxyApp proxy.AppConnConsensus,
+	executor state.Executor,
 	dalc *da.DAClient,
 	eventBus *cmtypes.EventBus,
 	logger log.Logger,
@@ -195,6 +249,35 @@ func NewManager(
 		conf.LazyBlockTime = defaultLazyBlockTime
 	}
 
+	if conf.AdaptiveBlockTime {
+		if conf.MinBlockTime == 0 {
+			logger.Info("Using default min block time", "MinBlockTime", defaultMinBlockTime)
+			conf.MinBlockTime = defaultMinBlockTime
+		}
+		if conf.MaxBlockTime == 0 {
+			logger.Info("Using default max block time", "MaxBlockTime", defaultMaxBlockTime)
+			conf.MaxBlockTime = defaultMaxBlockTime
+		}
+		if conf.MinBlockTime > conf.MaxBlockTime {
+			return nil, fmt.Errorf("min block time (%s) is greater than max block time (%s)", conf.MinBlockTime, conf.MaxBlockTime)
+		}
+	}
+
+	if conf.SequencerHeaderTimeout == 0 {
+		logger.Info("Using default sequencer header timeout", "SequencerHeaderTimeout", defaultSequencerHeaderTimeout)
+		conf.SequencerHeaderTimeout = defaultSequencerHeaderTimeout
+	}
+
+	if conf.SequencerDATimeout == 0 {
+		logger.Info("Using default sequencer DA timeout", "SequencerDATimeout", defaultSequencerDATimeout)
+		conf.SequencerDATimeout = defaultSequencerDATimeout
+	}
+
+	if conf.LeaseTTL == 0 {
+		logger.Info("Using default lease TTL", "LeaseTTL", defaultLeaseTTL)
+		conf.LeaseTTL = defaultLeaseTTL
+	}
+
 	if conf.DAMempoolTTL == 0 {
 		logger.Info("Using default mempool ttl", "MempoolTTL", defaultMempoolTTL)
 		conf.DAMempoolTTL = defaultMempoolTTL
@@ -217,7 +300,13 @@ func NewManager(
 	// allow buffer for the block header and protocol encoding
 	maxBlobSize -= blockProtocolOverhead
 
-	exec := state.NewBlockExecutor(proposerAddress, genesis.ChainID, mempool, proxyApp, eventBus, maxBlobSize, logger, execMetrics, valSet.Hash())
+	var exec *state.BlockExecutor
+	if executor != nil {
+		exec = state.NewBlockExecutor(proposerAddress, genesis.ChainID, executor, eventBus, maxBlobSize, logger, execMetrics, valSet.Hash())
+	} else {
+		exec = state.NewABCIBlockExecutor(proposerAddress, genesis.ChainID, mempool, proxyApp, eventBus, maxBlobSize, logger, execMetrics, valSet.Hash())
+	}
+	exec.SetConsensusHashHeight(conf.ConsensusHashHeight)
 	if s.LastBlockHeight+1 == uint64(genesis.InitialHeight) {
 		res, err := exec.InitChain(genesis)
 		if err != nil {
@@ -264,9 +353,19 @@ func NewManager(
 		validatorSet:  &valSet,
 		txsAvailable:  txsAvailableCh,
 		buildingBlock: false,
+		mempool:       mempool,
+		maxBlockBytes: maxBlobSize,
 		pendingBlocks: pendingBlocks,
 		metrics:       seqMetrics,
 		isProposer:    isProposer,
+		proofCh:       make(chan struct{}, 1),
+		proofInCh:     make(chan struct{}, 1),
+		executedCh:    make(chan struct{}, 1),
+		eventBus:      eventBus,
+		liveness:      newLivenessMonitor(time.Now()),
+	}
+	if err := agg.loadPreConfirmations(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to load pending pre-confirmations: %w", err)
 	}
 	return agg, nil
 }
@@ -284,6 +383,12 @@ func (m *Manager) SetDALC(dalc *da.DAClient) {
 	m.dalc = dalc
 }
 
+// SetKeyProvider is used to enable encrypted transactions mode, with key provider revealing decryption keys of
+// encrypted transactions.
+func (m *Manager) SetKeyProvider(keyProvider state.KeyProvider) {
+	m.executor.SetKeyProvider(keyProvider)
+}
+
 // isProposer returns whether or not the manager is a proposer
 func isProposer(genesis *cmtypes.GenesisDoc, signerPrivKey crypto.PrivKey) (bool, error) {
 	if len(genesis.Validators) == 0 {
@@ -390,6 +495,10 @@ func (m *Manager) AggregationLoop(ctx context.Context, lazy bool) {
 			// Define the start time for the block production period
 			start = time.Now()
 			err := m.publishBlock(ctx)
+			if errors.Is(err, ErrHalted) {
+				m.logger.Error("node halted, stopping block production")
+				return
+			}
 			if err != nil && ctx.Err() == nil {
 				m.logger.Error("error while publishing block", "error", err)
 			}
@@ -413,6 +522,10 @@ func (m *Manager) AggregationLoop(ctx context.Context, lazy bool) {
 		}
 		start := time.Now()
 		err := m.publishBlock(ctx)
+		if errors.Is(err, ErrHalted) {
+			m.logger.Error("node halted, stopping block production")
+			return
+		}
 		if err != nil && ctx.Err() == nil {
 			m.logger.Error("error while publishing block", "error", err)
 		}
@@ -420,7 +533,7 @@ func (m *Manager) AggregationLoop(ctx context.Context, lazy bool) {
 		// period based on the block time. Default sleep is set to 0
 		// because care about producing blocks on time vs giving time
 		// for transactions to accumulate.
-		blockTimer.Reset(getRemainingSleep(start, m.conf.BlockTime, 0))
+		blockTimer.Reset(getRemainingSleep(start, m.getBlockTime(), 0))
 	}
 }
 
@@ -434,13 +547,18 @@ func (m *Manager) BlockSubmissionLoop(ctx context.Context) {
 			return
 		case <-timer.C:
 		}
-		if m.pendingBlocks.isEmpty() {
+		if m.pendingBlocks.isEmpty() && m.prover == nil {
 			continue
 		}
 		err := m.submitBlocksToDA(ctx)
 		if err != nil {
 			m.logger.Error("error while submitting block to DA", "error", err)
 		}
+		if m.prover != nil {
+			if err := m.submitProofsToDA(ctx); err != nil {
+				m.logger.Error("error while submitting validity proofs to DA", "error", err)
+			}
+		}
 	}
 }
 
@@ -459,6 +577,12 @@ func (m *Manager) SyncLoop(ctx context.Context, cancel context.CancelFunc) {
 			m.sendNonBlockingSignalToRetrieveCh()
 		case <-blockTicker.C:
 			m.sendNonBlockingSignalToBlockStoreCh()
+			// retry the next block, if it's deferred until its time is reached
+			if _, ok := m.blockCache.getBlock(m.store.Height() + 1); ok {
+				if err := m.trySyncNextBlock(ctx, atomic.LoadUint64(&m.daHeight)); err != nil {
+					m.logger.Info("failed to sync next block", "error", err)
+				}
+			}
 		case blockEvent := <-m.blockInCh:
 			// Only validated blocks are sent to blockInCh, so we can safely assume that blockEvent.block is valid
 			block := blockEvent.Block
@@ -470,10 +594,24 @@ func (m *Manager) SyncLoop(ctx context.Context, cancel context.CancelFunc) {
 				"daHeight", daHeight,
 				"hash", blockHash,
 			)
+			if err := m.checkDivergence(ctx, block); err != nil {
+				m.logger.Error("failed to process block", "height", blockHeight, "hash", blockHash, "error", err)
+				continue
+			}
 			if blockHeight <= m.store.Height() || m.blockCache.isSeen(blockHash) {
 				m.logger.Debug("block already seen", "height", blockHeight, "block hash", blockHash)
 				continue
 			}
+			if err := m.checkBlockTime(blockEvent); err != nil {
+				if errors.Is(err, types.ErrTimeTooFarInFuture) {
+					// valid once the local clock catches up, retried by trySyncNextBlock on every block tick
+					m.logger.Info("block time ahead of local clock, deferring block", "height", blockHeight, "hash", blockHash, "error", err)
+					m.blockCache.setBlock(blockHeight, block)
+					continue
+				}
+				m.logger.Error("rejecting block with invalid time", "height", blockHeight, "hash", blockHash, "error", err)
+				continue
+			}
 			m.blockCache.setBlock(blockHeight, block)
 
 			m.sendNonBlockingSignalToBlockStoreCh()
@@ -485,6 +623,16 @@ func (m *Manager) SyncLoop(ctx context.Context, cancel context.CancelFunc) {
 				continue
 			}
 			m.blockCache.setSeen(blockHash)
+		case <-m.proofInCh:
+			err := m.trySyncNextBlock(ctx, atomic.LoadUint64(&m.daHeight))
+			if err != nil {
+				m.logger.Info("failed to sync next block", "error", err)
+			}
+		case <-m.executedCh:
+			err := m.trySyncNextBlock(ctx, atomic.LoadUint64(&m.daHeight))
+			if err != nil {
+				m.logger.Info("failed to sync next block", "error", err)
+			}
 		case <-ctx.Done():
 			return
 		}
@@ -509,8 +657,8 @@ func (m *Manager) sendNonBlockingSignalToRetrieveCh() {
 //
 //	Note: the blockCache contains only valid blocks that are not yet synced
 //
-// For every block, to be able to apply block at height h, we need to have its Commit. It is contained in block at height h+1.
-// If commit for block h+1 is available, we proceed with sync process, and remove synced block from sync cache.
+// In optimistic execution mode, validated block is executed in the background, while its validity proof is awaited and
+// verified, and it's committed once both are done (see tryCommitOptimistic).
 func (m *Manager) trySyncNextBlock(ctx context.Context, daHeight uint64) error {
 	for {
 		select {
@@ -518,6 +666,16 @@ func (m *Manager) trySyncNextBlock(ctx context.Context, daHeight uint64) error {
 			return ctx.Err()
 		default:
 		}
+		if m.isHalted() {
+			return ErrHalted
+		}
+		if m.optimistic != nil {
+			committed, err := m.tryCommitOptimistic(ctx, daHeight)
+			if err != nil || !committed {
+				return err
+			}
+			continue
+		}
 		currentHeight := m.store.Height()
 		b, ok := m.blockCache.getBlock(currentHeight + 1)
 		if !ok {
@@ -526,45 +684,75 @@ func (m *Manager) trySyncNextBlock(ctx context.Context, daHeight uint64) error {
 		}
 
 		bHeight := b.Height()
+		if drift := time.Until(b.Time()); drift > types.MaxClockDrift {
+			m.logger.Debug("waiting for block time", "height", bHeight, "drift", drift)
+			return nil
+		}
 		m.logger.Info("Syncing block", "height", bHeight)
 		// Validate the received block before applying
 		if err := m.executor.Validate(m.lastState, b); err != nil {
 			return fmt.Errorf("failed to validate block: %w", err)
 		}
+		if m.optimisticExecution() {
+			m.logger.Debug("executing block optimistically", "height", bHeight)
+			m.executeOptimistic(ctx, b)
+			continue
+		}
+		if err := m.verifyProof(ctx, b); err != nil {
+			if errors.Is(err, ErrProofNotAvailable) {
+				m.logger.Debug("waiting for validity proof", "height", bHeight)
+				return nil
+			}
+			return fmt.Errorf("failed to verify validity proof: %w", err)
+		}
 		newState, responses, err := m.applyBlock(ctx, b)
 		if err != nil {
 			if ctx.Err() != nil {
 				return err
 			}
 			// if call to applyBlock fails, we halt the node, see https://github.com/cometbft/cometbft/pull/496
-			panic(fmt.Errorf("failed to ApplyBlock: %w", err))
-		}
-		err = m.store.SaveBlock(ctx, b, &b.SignedHeader.Commit)
-		if err != nil {
-			return fmt.Errorf("failed to save block: %w", err)
+			return m.halt(ctx, b, fmt.Errorf("failed to apply block: %w", err))
 		}
-		_, _, err = m.executor.Commit(ctx, newState, b, responses)
-		if err != nil {
-			return fmt.Errorf("failed to Commit: %w", err)
+		if err := m.commitSyncedBlock(ctx, b, newState, responses, daHeight); err != nil {
+			return err
 		}
+	}
+}
 
-		err = m.store.SaveBlockResponses(ctx, bHeight, responses)
-		if err != nil {
-			return fmt.Errorf("failed to save block responses: %w", err)
-		}
+// commitSyncedBlock saves executed block and commits the state.
+func (m *Manager) commitSyncedBlock(ctx context.Context, b *types.Block, newState types.State, responses *abci.ResponseFinalizeBlock, daHeight uint64) error {
+	bHeight := b.Height()
+	err := m.store.SaveBlock(ctx, b, &b.SignedHeader.Commit)
+	if err != nil {
+		return fmt.Errorf("failed to save block: %w", err)
+	}
+	_, _, err = m.executor.Commit(ctx, newState, b, responses)
+	if err != nil {
+		return fmt.Errorf("failed to Commit: %w", err)
+	}
 
-		// Height gets updated
-		m.store.SetHeight(ctx, bHeight)
+	err = m.store.SaveBlockResponses(ctx, bHeight, responses)
+	if err != nil {
+		return fmt.Errorf("failed to save block responses: %w", err)
+	}
 
-		if daHeight > newState.DAHeight {
-			newState.DAHeight = daHeight
-		}
-		err = m.updateState(ctx, newState)
-		if err != nil {
-			m.logger.Error("failed to save updated state", "error", err)
-		}
-		m.blockCache.deleteBlock(currentHeight + 1)
+	// Height gets updated
+	m.store.SetHeight(ctx, bHeight)
+
+	if daHeight > newState.DAHeight {
+		newState.DAHeight = daHeight
 	}
+	err = m.updateState(ctx, newState)
+	if err != nil {
+		m.logger.Error("failed to save updated state", "error", err)
+	}
+	m.blockCache.deleteBlock(bHeight)
+	m.trackIncluded(b)
+	if m.blockCache.isDAIncluded(b.Hash().String()) {
+		m.setFinal(ctx, bHeight)
+	}
+	m.checkPreConfirmations(ctx, b)
+	return nil
 }
 
 // BlockStoreRetrieveLoop is responsible for retrieving blocks from the Block Store.
@@ -601,7 +789,7 @@ func (m *Manager) BlockStoreRetrieveLoop(ctx context.Context) {
 					continue
 				}
 				m.logger.Debug("block retrieved from p2p block sync", "blockHeight", block.Height(), "daHeight", daHeight)
-				m.blockInCh <- NewBlockEvent{block, daHeight}
+				m.blockInCh <- NewBlockEvent{Block: block, DAHeight: daHeight}
 			}
 		}
 		lastBlockStoreHeight = blockStoreHeight
@@ -689,9 +877,11 @@ func (m *Manager) processNextDABlock(ctx context.Context) error {
 						"blockHash", block.Hash().String())
 					continue
 				}
+				if err := m.markDAIncluded(ctx, block, daHeight, blockResp.Timestamp); err != nil {
+					m.logger.Error("skipping DA block", "blockHeight", block.Height(), "blockHash", block.Hash().String(), "error", err)
+					continue
+				}
 				blockHash := block.Hash().String()
-				m.blockCache.setDAIncluded(blockHash)
-				m.logger.Info("block marked as DA included", "blockHeight", block.Height(), "blockHash", blockHash)
 				if !m.blockCache.isSeen(blockHash) {
 					// Check for shut down event prior to logging
 					// and sending block to blockInCh. The reason
@@ -704,9 +894,12 @@ func (m *Manager) processNextDABlock(ctx context.Context) error {
 						return pkgErrors.WithMessage(ctx.Err(), "unable to send block to blockInCh, context done")
 					default:
 					}
-					m.blockInCh <- NewBlockEvent{block, daHeight}
+					m.blockInCh <- NewBlockEvent{Block: block, DAHeight: daHeight, DATime: blockResp.Timestamp}
 				}
 			}
+			if m.verifier != nil {
+				m.cacheProofs(blockResp.Proofs, daHeight)
+			}
 			return nil
 		}
 
@@ -722,8 +915,38 @@ func (m *Manager) processNextDABlock(ctx context.Context) error {
 	return err
 }
 
+// markDAIncluded marks the block retrieved from DA as DA included, and finalizes it if it's already synced.
+//
+// Block conflicting with the block already known at the same height (see checkDivergence) is not marked, so the known
+// block is not finalized and its transactions are not reported as DA included. The same applies to block ahead of the
+// time of DA block by more than MaxDATimeDrift, even if it was already synced (e.g. from P2P network, without DA time).
+func (m *Manager) markDAIncluded(ctx context.Context, block *types.Block, daHeight uint64, daTime time.Time) error {
+	if err := m.checkDivergence(ctx, block); err != nil {
+		return err
+	}
+	if err := m.checkDATimeDrift(block, daHeight, daTime); err != nil {
+		return err
+	}
+	blockHash := block.Hash()
+	m.blockCache.setDAIncluded(blockHash.String())
+	m.setDAIncluded(time.Now())
+	m.trackDAIncluded(block, daHeight)
+	m.logger.Info("block marked as DA included", "blockHeight", block.Height(), "blockHash", blockHash.String())
+	// blocks synced later are finalized by trySyncNextBlock
+	if stored, err := m.store.GetBlock(ctx, block.Height()); err == nil && bytes.Equal(stored.Hash(), blockHash) {
+		m.setFinal(ctx, block.Height())
+	}
+	return nil
+}
+
+// isUsingExpectedCentralizedSequencer checks that the block is valid and proposed by the expected sequencer. Block ahead
+// of the local clock is accepted, as it's deferred until its time is reached.
 func (m *Manager) isUsingExpectedCentralizedSequencer(block *types.Block) bool {
-	return bytes.Equal(block.SignedHeader.ProposerAddress, m.genesis.Validators[0].Address.Bytes()) && block.ValidateBasic() == nil
+	if !bytes.Equal(block.SignedHeader.ProposerAddress, m.genesis.Validators[0].Address.Bytes()) {
+		return false
+	}
+	err := block.ValidateBasic()
+	return err == nil || errors.Is(err, types.ErrTimeTooFarInFuture)
 }
 
 func (m *Manager) fetchBlock(ctx context.Context, daHeight uint64) (da.ResultRetrieveBlocks, error) {
@@ -775,8 +998,12 @@ func (m *Manager) publishBlock(ctx context.Context) error {
 		return ErrNotProposer
 	}
 
-	if m.conf.MaxPendingBlocks != 0 && m.pendingBlocks.numPendingBlocks() >= m.conf.MaxPendingBlocks {
-		return fmt.Errorf("number of blocks pending DA submission (%d) reached configured limit (%d)", m.pendingBlocks.numPendingBlocks(), m.conf.MaxPendingBlocks)
+	if m.isHalted() {
+		return ErrHalted
+	}
+
+	if backlog := m.DABacklog(); backlog.Overloaded {
+		return fmt.Errorf("number of blocks pending DA submission (%d) reached configured limit (%d)", backlog.PendingBlocks, backlog.MaxPendingBlocks)
 	}
 
 	var (
@@ -787,6 +1014,12 @@ func (m *Manager) publishBlock(ctx context.Context) error {
 	height := m.store.Height()
 	newHeight := height + 1
 
+	unlock, err := m.reserveHeight(ctx, newHeight)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	// this is a special case, when first block is produced - there is no previous commit
 	if newHeight == uint64(m.genesis.InitialHeight) {
 		lastCommit = &types.Commit{}
@@ -855,7 +1088,7 @@ func (m *Manager) publishBlock(ctx context.Context) error {
 			return err
 		}
 		// if call to applyBlock fails, we halt the node, see https://github.com/cometbft/cometbft/pull/496
-		panic(err)
+		return m.halt(ctx, block, fmt.Errorf("failed to apply block: %w", err))
 	}
 
 	// Before taking the hash, we need updated ISRs, hence after ApplyBlock
@@ -881,6 +1114,10 @@ func (m *Manager) publishBlock(ctx context.Context) error {
 	}
 
 	blockHeight := block.Height()
+	// Block is submitted to DA as soon as the height is updated, so it has to be recorded as published first
+	if err := m.markPublished(ctx, blockHeight); err != nil {
+		return err
+	}
 	// Update the stored height before submitting to the DA layer and committing to the DB
 	m.store.SetHeight(ctx, blockHeight)
 
@@ -915,6 +1152,8 @@ func (m *Manager) publishBlock(ctx context.Context) error {
 		return err
 	}
 	m.recordMetrics(block)
+	m.trackIncluded(block)
+	m.sendNonBlockingSignalToProofCh()
 	// Check for shut down event prior to sending the header and block to
 	// their respective channels. The reason for checking for the shutdown
 	// event separately is due to the inconsistent nature of the select
@@ -1043,12 +1282,15 @@ daSubmitRetryLoop:
 			numSubmittedBlocks += len(submittedBlocks)
 			for _, block := range submittedBlocks {
 				m.blockCache.setDAIncluded(block.Hash().String())
+				m.trackDAIncluded(block, res.DAHeight)
 			}
 			lastSubmittedHeight := uint64(0)
 			if l := len(submittedBlocks); l > 0 {
 				lastSubmittedHeight = submittedBlocks[l-1].Height()
 			}
 			m.pendingBlocks.setLastSubmittedHeight(ctx, lastSubmittedHeight)
+			m.setFinal(ctx, lastSubmittedHeight)
+			m.metrics.DABacklog.Set(float64(m.pendingBlocks.numPendingBlocks()))
 			blocksToSubmit = notSubmittedBlocks
 			// reset submission options when successful
 			// scale back gasPrice gradually
@@ -1133,6 +1375,21 @@ func (m *Manager) applyBlock(ctx context.Context, block *types.Block) (types.Sta
 	return m.executor.ApplyBlock(ctx, m.lastState, block)
 }
 
+// setFinal marks blocks included in DA layer as final in the executor. Only executed blocks are marked.
+func (m *Manager) setFinal(ctx context.Context, height uint64) {
+	m.finalHeightMtx.Lock()
+	defer m.finalHeightMtx.Unlock()
+	height = min(height, m.store.Height())
+	if height <= m.finalHeight {
+		return
+	}
+	if err := m.executor.SetFinal(ctx, height); err != nil {
+		m.logger.Error("failed to set final height", "height", height, "error", err)
+		return
+	}
+	m.finalHeight = height
+}
+
 func updateState(s *types.State, res *abci.ResponseInitChain) {
 	// If the app did not return an app hash, we keep the one set from the genesis doc in
 	// the state. We don't set appHash since we don't want the genesis doc app hash
@@ -1142,25 +1399,9 @@ func updateState(s *types.State, res *abci.ResponseInitChain) {
 	}
 
 	if res.ConsensusParams != nil {
-		params := res.ConsensusParams
-		if params.Block != nil {
-			s.ConsensusParams.Block.MaxBytes = params.Block.MaxBytes
-			s.ConsensusParams.Block.MaxGas = params.Block.MaxGas
-		}
-		if params.Evidence != nil {
-			s.ConsensusParams.Evidence.MaxAgeNumBlocks = params.Evidence.MaxAgeNumBlocks
-			s.ConsensusParams.Evidence.MaxAgeDuration = params.Evidence.MaxAgeDuration
-			s.ConsensusParams.Evidence.MaxBytes = params.Evidence.MaxBytes
-		}
-		if params.Validator != nil {
-			// Copy params.Validator.PubkeyTypes, and set result's value to the copy.
-			// This avoids having to initialize the slice to 0 values, and then write to it again.
-			s.ConsensusParams.Validator.PubKeyTypes = append([]string{}, params.Validator.PubKeyTypes...)
-		}
-		if params.Version != nil {
-			s.ConsensusParams.Version.App = params.Version.App
-		}
-		s.Version.Consensus.App = s.ConsensusParams.Version.App
+		params := types.ConsensusParamsFromProto(s.ConsensusParams).Update(res.ConsensusParams)
+		s.ConsensusParams = params.ToProto()
+		s.Version.Consensus.App = params.Version.App
 	}
 	// We update the last results hash with the empty hash, to conform with RFC-6962.
 	s.LastResultsHash = merkle.HashFromByteSlices(nil)
@@ -14,6 +14,7 @@ import (
 	ds "github.com/ipfs/go-datastore"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	goDA "github.com/rollkit/go-da"
@@ -21,6 +22,7 @@ import (
 
 	"github.com/rollkit/rollkit/da"
 	"github.com/rollkit/rollkit/da/mock"
+	"github.com/rollkit/rollkit/state"
 	"github.com/rollkit/rollkit/store"
 	test "github.com/rollkit/rollkit/test/log"
 	"github.com/rollkit/rollkit/test/mocks"
@@ -33,7 +35,9 @@ func getManager(t *testing.T, backend goDA.DA) *Manager {
 	return &Manager{
 		dalc:       da.NewDAClient(backend, -1, -1, nil, logger),
 		blockCache: NewBlockCache(),
+		executor:   state.NewBlockExecutor(nil, "test", state.NewMockExecutor(), nil, 0, logger, state.NopMetrics(), nil),
 		logger:     logger,
+		metrics:    NopMetrics(),
 	}
 }
 
@@ -337,9 +341,7 @@ func Test_submitBlocksToDA_BlockMarshalErrorCase1(t *testing.T) {
 	store := mocks.NewStore(t)
 	invalidateBlockHeader(block1)
 	store.On("GetMetadata", ctx, LastSubmittedHeightKey).Return(nil, ds.ErrNotFound)
-	store.On("GetBlock", ctx, uint64(1)).Return(block1, nil)
-	store.On("GetBlock", ctx, uint64(2)).Return(block2, nil)
-	store.On("GetBlock", ctx, uint64(3)).Return(block3, nil)
+	mockIterateBlocks(store, ctx, block1, block2, block3)
 	store.On("Height").Return(uint64(3))
 
 	m.store = store
@@ -372,9 +374,7 @@ func Test_submitBlocksToDA_BlockMarshalErrorCase2(t *testing.T) {
 	invalidateBlockHeader(block3)
 	store.On("SetMetadata", ctx, LastSubmittedHeightKey, []byte(strconv.FormatUint(2, 10))).Return(nil)
 	store.On("GetMetadata", ctx, LastSubmittedHeightKey).Return(nil, ds.ErrNotFound)
-	store.On("GetBlock", ctx, uint64(1)).Return(block1, nil)
-	store.On("GetBlock", ctx, uint64(2)).Return(block2, nil)
-	store.On("GetBlock", ctx, uint64(3)).Return(block3, nil)
+	mockIterateBlocks(store, ctx, block1, block2, block3)
 	store.On("Height").Return(uint64(3))
 
 	m.store = store
@@ -389,6 +389,18 @@ func Test_submitBlocksToDA_BlockMarshalErrorCase2(t *testing.T) {
 	assert.Equal(1, len(blocks))
 }
 
+// mockIterateBlocks sets up IterateBlocks of the store mock to iterate over given blocks, starting at height 1.
+func mockIterateBlocks(store *mocks.Store, ctx context.Context, blocks ...*types.Block) {
+	store.On("IterateBlocks", ctx, testifymock.Anything, testifymock.Anything, testifymock.Anything).Run(func(args testifymock.Arguments) {
+		from, to, fn := args.Get(1).(uint64), args.Get(2).(uint64), args.Get(3).(func(*types.Block) error)
+		for h := from; h <= to; h++ {
+			if err := fn(blocks[h-1]); err != nil {
+				return
+			}
+		}
+	}).Return(nil)
+}
+
 // invalidateBlockHeader results in a block header that produces a marshalling error
 func invalidateBlockHeader(block *types.Block) {
 	for i := range block.SignedHeader.Validators.Validators {
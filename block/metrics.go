@@ -26,6 +26,20 @@ type Metrics struct {
 	TotalTxs metrics.Gauge
 	// The latest block height.
 	CommittedHeight metrics.Gauge `metrics_name:"latest_block_height"`
+	// Effective block time in adaptive mode, in seconds.
+	EffectiveBlockTime metrics.Gauge `metrics_name:"effective_block_time_seconds"`
+	// Number of heights at which sequencer published conflicting blocks.
+	DivergentBlocks metrics.Counter
+	// Time since the last new header was received from the sequencer, in seconds.
+	TimeSinceLastHeader metrics.Gauge `metrics_name:"time_since_last_header_seconds"`
+	// Time since the last sequencer block was found on DA, in seconds.
+	TimeSinceLastDAIncluded metrics.Gauge `metrics_name:"time_since_last_da_included_seconds"`
+	// Whether the sequencer is stalled (1) or not (0).
+	SequencerStalled metrics.Gauge
+	// Number of pre-confirmations not honored by the sequencer.
+	BrokenPreConfirmations metrics.Counter
+	// Number of blocks pending DA submission.
+	DABacklog metrics.Gauge `metrics_name:"da_backlog_blocks"`
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -67,16 +81,66 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "latest_block_height",
 			Help:      "The latest block height.",
 		}, labels).With(labelsAndValues...),
+		EffectiveBlockTime: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "effective_block_time_seconds",
+			Help:      "Effective block time in adaptive mode, in seconds.",
+		}, labels).With(labelsAndValues...),
+		DivergentBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "divergent_blocks",
+			Help:      "Number of heights at which sequencer published conflicting blocks.",
+		}, labels).With(labelsAndValues...),
+		TimeSinceLastHeader: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "time_since_last_header_seconds",
+			Help:      "Time since the last new header was received from the sequencer, in seconds.",
+		}, labels).With(labelsAndValues...),
+		TimeSinceLastDAIncluded: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "time_since_last_da_included_seconds",
+			Help:      "Time since the last sequencer block was found on DA, in seconds.",
+		}, labels).With(labelsAndValues...),
+		SequencerStalled: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sequencer_stalled",
+			Help:      "Whether the sequencer is stalled (1) or not (0).",
+		}, labels).With(labelsAndValues...),
+		BrokenPreConfirmations: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "broken_preconfirmations",
+			Help:      "Number of pre-confirmations not honored by the sequencer.",
+		}, labels).With(labelsAndValues...),
+		DABacklog: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "da_backlog_blocks",
+			Help:      "Number of blocks pending DA submission.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
 // NopMetrics returns no-op Metrics.
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Height:          discard.NewGauge(),
-		NumTxs:          discard.NewGauge(),
-		BlockSizeBytes:  discard.NewGauge(),
-		TotalTxs:        discard.NewGauge(),
-		CommittedHeight: discard.NewGauge(),
+		Height:             discard.NewGauge(),
+		NumTxs:             discard.NewGauge(),
+		BlockSizeBytes:     discard.NewGauge(),
+		TotalTxs:           discard.NewGauge(),
+		CommittedHeight:    discard.NewGauge(),
+		EffectiveBlockTime: discard.NewGauge(),
+		DivergentBlocks:    discard.NewCounter(),
+
+		TimeSinceLastHeader:     discard.NewGauge(),
+		TimeSinceLastDAIncluded: discard.NewGauge(),
+		SequencerStalled:        discard.NewGauge(),
+		BrokenPreConfirmations:  discard.NewCounter(),
+		DABacklog:               discard.NewGauge(),
 	}
 }
@@ -0,0 +1,94 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// optimisticBlock is a block executed in the background, with the results of the execution.
+//
+// Results are set before done is closed.
+type optimisticBlock struct {
+	block *types.Block
+	done  chan struct{}
+
+	state     types.State
+	responses *abci.ResponseFinalizeBlock
+	err       error
+}
+
+// optimisticExecution returns true if synced blocks are executed optimistically.
+//
+// Aggregators (including hot-standby ones) never execute blocks optimistically, as they have to be able to produce
+// blocks on top of committed state.
+func (m *Manager) optimisticExecution() bool {
+	return m.conf.OptimisticExecution && !m.isProposer
+}
+
+// executeOptimistic starts execution of validated block in the background. SyncLoop is notified when execution is
+// finished.
+//
+// Block is executed on top of the last committed state, so next block can't be executed before this one is committed.
+func (m *Manager) executeOptimistic(ctx context.Context, block *types.Block) {
+	m.lastStateMtx.RLock()
+	lastState := m.lastState
+	m.lastStateMtx.RUnlock()
+
+	pending := &optimisticBlock{block: block, done: make(chan struct{})}
+	m.optimistic = pending
+	go func() {
+		defer m.sendNonBlockingSignalToExecutedCh()
+		defer close(pending.done)
+		pending.state, pending.responses, pending.err = m.executor.ApplyBlock(ctx, lastState, block)
+	}()
+}
+
+// tryCommitOptimistic commits the block executed in the background, once its validity proof (if required) is verified
+// and execution is finished. It returns true if the block was committed.
+//
+// Proof is awaited and verified while the block is executed; this is the only overlap optimistic execution provides.
+// Block was already validated, so it's never replaced by another block at the same height, and the result of execution
+// is never rolled back. If execution was interrupted by cancellation of ctx, the result is discarded without updating
+// the store or the state, and the block is executed again by the next call to trySyncNextBlock.
+func (m *Manager) tryCommitOptimistic(ctx context.Context, daHeight uint64) (bool, error) {
+	pending := m.optimistic
+	height := pending.block.Height()
+	if err := m.verifyProof(ctx, pending.block); err != nil {
+		if errors.Is(err, ErrProofNotAvailable) {
+			m.logger.Debug("waiting for validity proof", "height", height)
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to verify validity proof: %w", err)
+	}
+	select {
+	case <-pending.done:
+	default:
+		m.logger.Debug("waiting for execution of block", "height", height)
+		return false, nil
+	}
+
+	m.optimistic = nil
+	if pending.err != nil {
+		if ctx.Err() != nil {
+			return false, pending.err
+		}
+		// if call to applyBlock fails, we halt the node, see https://github.com/cometbft/cometbft/pull/496
+		return false, m.halt(ctx, pending.block, fmt.Errorf("failed to apply block: %w", pending.err))
+	}
+	if err := m.commitSyncedBlock(ctx, pending.block, pending.state, pending.responses, daHeight); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *Manager) sendNonBlockingSignalToExecutedCh() {
+	select {
+	case m.executedCh <- struct{}{}:
+	default:
+	}
+}
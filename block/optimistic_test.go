@@ -0,0 +1,236 @@
+package block
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/state"
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+func getOptimisticManager(t *testing.T) *Manager {
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	logger := test.NewLogger(t)
+	return &Manager{
+		conf:         config.BlockManagerConfig{OptimisticExecution: true},
+		store:        store.New(kv),
+		blockCache:   NewBlockCache(),
+		executor:     state.NewBlockExecutor(nil, "test", state.NewMockExecutor(), nil, 0, logger, state.NopMetrics(), nil),
+		logger:       logger,
+		lastStateMtx: new(sync.RWMutex),
+		metrics:      NopMetrics(),
+	}
+}
+
+// setOptimistic sets block executed in the background, with execution finished if executed is true.
+func setOptimistic(m *Manager, block *types.Block, executed bool) {
+	m.blockCache.setBlock(block.Height(), block)
+	m.optimistic = &optimisticBlock{
+		block:     block,
+		done:      make(chan struct{}),
+		state:     types.State{LastBlockHeight: block.Height(), AppHash: block.SignedHeader.AppHash},
+		responses: &abci.ResponseFinalizeBlock{AppHash: block.SignedHeader.AppHash},
+	}
+	if executed {
+		close(m.optimistic.done)
+	}
+}
+
+func TestOptimisticExecution(t *testing.T) {
+	m := getOptimisticManager(t)
+	assert.True(t, m.optimisticExecution())
+	m.isProposer = true
+	assert.False(t, m.optimisticExecution())
+	m.isProposer = false
+	m.conf.OptimisticExecution = false
+	assert.False(t, m.optimisticExecution())
+}
+
+func TestTryCommitOptimistic(t *testing.T) {
+	ctx := context.Background()
+	block, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 2})
+
+	t.Run("committed after execution", func(t *testing.T) {
+		require := require.New(t)
+		m := getOptimisticManager(t)
+		setOptimistic(m, block, false)
+
+		committed, err := m.tryCommitOptimistic(ctx, 5)
+		require.NoError(err)
+		require.False(committed)
+		require.EqualValues(0, m.store.Height())
+		require.NotNil(m.optimistic)
+
+		close(m.optimistic.done)
+		committed, err = m.tryCommitOptimistic(ctx, 5)
+		require.NoError(err)
+		require.True(committed)
+		require.Nil(m.optimistic)
+		require.EqualValues(1, m.store.Height())
+		require.EqualValues(1, m.lastState.LastBlockHeight)
+		require.EqualValues(5, m.lastState.DAHeight)
+		_, ok := m.blockCache.getBlock(1)
+		require.False(ok)
+		saved, err := m.store.GetBlock(ctx, 1)
+		require.NoError(err)
+		require.Equal(block.Hash(), saved.Hash())
+	})
+
+	t.Run("waiting for proof", func(t *testing.T) {
+		require := require.New(t)
+		m := getOptimisticManager(t)
+		m.conf.RequireProofs = true
+		m.SetVerifier(NewMockProver())
+		setOptimistic(m, block, true)
+
+		committed, err := m.tryCommitOptimistic(ctx, 0)
+		require.NoError(err)
+		require.False(committed)
+		require.NotNil(m.optimistic)
+		require.EqualValues(0, m.store.Height())
+	})
+}
+
+func TestExecuteOptimisticFailure(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m := getOptimisticManager(t)
+	m.executedCh = make(chan struct{}, 1)
+	m.lastState = types.State{InitialHeight: 1}
+
+	// block doesn't match the state, so it fails to execute
+	block, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1})
+	m.blockCache.setBlock(1, block)
+	m.executeOptimistic(ctx, block)
+	<-m.executedCh
+
+	committed, err := m.tryCommitOptimistic(ctx, 0)
+	require.ErrorIs(err, ErrHalted)
+	require.False(committed)
+	require.True(m.isHalted())
+	require.Nil(m.optimistic)
+	require.EqualValues(0, m.store.Height())
+}
+
+// slowExecutor is a state.Executor that takes delay to execute transactions.
+type slowExecutor struct {
+	*state.MockExecutor
+	delay time.Duration
+}
+
+func (e *slowExecutor) ExecuteTxs(ctx context.Context, txs [][]byte, height uint64, timestamp time.Time, prevStateRoot []byte) ([]byte, uint64, error) {
+	select {
+	case <-time.After(e.delay):
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	}
+	return e.MockExecutor.ExecuteTxs(ctx, txs, height, timestamp, prevStateRoot)
+}
+
+// getSyncManager returns manager with a valid block at height 1 in the block cache, executed by exec.
+func getSyncManager(t *testing.T, exec state.Executor) (*Manager, *types.Block) {
+	block, privKey := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 2})
+	params := cmproto.ConsensusParams{Block: &cmproto.BlockParams{MaxBytes: 1024, MaxGas: -1}}
+	consensusHash, err := types.ConsensusHash(params)
+	require.NoError(t, err)
+	block.SignedHeader.ConsensusHash = consensusHash
+	commit, err := types.GetCommit(block.SignedHeader.Header, privKey)
+	require.NoError(t, err)
+	block.SignedHeader.Commit = *commit
+
+	m := getOptimisticManager(t)
+	m.executor = state.NewBlockExecutor(nil, block.SignedHeader.ChainID(), exec, nil, 0, m.logger, state.NopMetrics(), nil)
+	m.executedCh = make(chan struct{}, 1)
+	m.lastState = types.State{
+		ChainID:         block.SignedHeader.ChainID(),
+		InitialHeight:   1,
+		AppHash:         block.SignedHeader.AppHash,
+		LastResultsHash: block.SignedHeader.LastResultsHash,
+		ConsensusParams: params,
+	}
+	m.lastState.Version.Consensus.Block = block.SignedHeader.Version.Block
+	m.lastState.Version.Consensus.App = block.SignedHeader.Version.App
+	m.blockCache.setBlock(1, block)
+	return m, block
+}
+
+func TestOptimisticExecutionLatency(t *testing.T) {
+	const delay = 200 * time.Millisecond
+	ctx := context.Background()
+
+	// sync measures time to sync the block, if both its execution and delivery of its validity proof take delay
+	sync := func(t *testing.T, optimistic bool) time.Duration {
+		m, block := getSyncManager(t, &slowExecutor{MockExecutor: state.NewMockExecutor(), delay: delay})
+		m.conf.OptimisticExecution = optimistic
+		m.conf.RequireProofs = true
+		prover := NewMockProver()
+		m.SetVerifier(prover)
+		proof, err := prover.Prove(ctx, block)
+		require.NoError(t, err)
+
+		start := time.Now()
+		go func() {
+			time.Sleep(delay)
+			m.cacheProofs([]*types.ValidityProof{{Height: 1, BlockHash: block.Hash(), Proof: proof}}, 1)
+		}()
+		for m.store.Height() < 1 {
+			require.NoError(t, m.trySyncNextBlock(ctx, 1))
+			time.Sleep(time.Millisecond)
+		}
+		return time.Since(start)
+	}
+
+	serial := sync(t, false)
+	optimistic := sync(t, true)
+	assert.GreaterOrEqual(t, serial, 2*delay)
+	assert.Less(t, optimistic, 2*delay)
+	assert.Less(t, optimistic, serial)
+}
+
+func TestOptimisticExecutionDiscarded(t *testing.T) {
+	require := require.New(t)
+	exec := &slowExecutor{MockExecutor: state.NewMockExecutor(), delay: time.Hour}
+	m, block := getSyncManager(t, exec)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(m.trySyncNextBlock(ctx, 0))
+	require.NotNil(m.optimistic)
+
+	// node is stopped during execution
+	cancel()
+	<-m.executedCh
+	committed, err := m.tryCommitOptimistic(ctx, 0)
+	require.ErrorIs(err, context.Canceled)
+	require.False(committed)
+	require.Nil(m.optimistic)
+	require.False(m.isHalted())
+	require.EqualValues(0, m.store.Height())
+	require.EqualValues(0, m.lastState.LastBlockHeight)
+	require.Empty(exec.ExecutedTxs())
+	_, ok := m.blockCache.getBlock(1)
+	require.True(ok)
+
+	// block is executed again
+	exec.delay = 0
+	ctx = context.Background()
+	for m.store.Height() < 1 {
+		require.NoError(m.trySyncNextBlock(ctx, 0))
+		time.Sleep(time.Millisecond)
+	}
+	require.EqualValues(1, m.lastState.LastBlockHeight)
+	require.Len(exec.ExecutedTxs(), 2)
+	saved, err := m.store.GetBlock(ctx, 1)
+	require.NoError(err)
+	require.Equal(block.Hash(), saved.Hash())
+}
@@ -64,15 +64,12 @@ func (pb *PendingBlocks) getPendingBlocks(ctx context.Context) ([]*types.Block,
 	}
 
 	blocks := make([]*types.Block, 0, height-lastSubmitted)
-	for i := lastSubmitted + 1; i <= height; i++ {
-		block, err := pb.store.GetBlock(ctx, i)
-		if err != nil {
-			// return as much as possible + error information
-			return blocks, err
-		}
+	err := pb.store.IterateBlocks(ctx, lastSubmitted+1, height, func(block *types.Block) error {
 		blocks = append(blocks, block)
-	}
-	return blocks, nil
+		return nil
+	})
+	// return as much as possible + error information
+	return blocks, err
 }
 
 func (pb *PendingBlocks) isEmpty() bool {
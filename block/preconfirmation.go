@@ -0,0 +1,290 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmjson "github.com/cometbft/cometbft/libs/json"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// BrokenPreConfirmationKey is the prefix of keys used for persisting pre-confirmations not honored by the sequencer.
+//
+// Broken pre-confirmation is stored under "BrokenPreConfirmationKey/tx hash" key.
+const BrokenPreConfirmationKey = "broken preconfirmation"
+
+// PendingPreConfirmationsKey is the key used for persisting pre-confirmations tracked by full node, until they're
+// settled by synced blocks.
+const PendingPreConfirmationsKey = "pending preconfirmations"
+
+// EventPreConfirmationBroken is published when sequencer didn't honor a pre-confirmation.
+const EventPreConfirmationBroken = "PreConfirmationBroken"
+
+var (
+	// ErrPreConfirmationsDisabled is returned when pre-confirmations are requested, but not enabled.
+	ErrPreConfirmationsDisabled = errors.New("pre-confirmations are disabled")
+
+	// ErrWrongChainID is returned when pre-confirmation was issued for another chain.
+	ErrWrongChainID = errors.New("wrong chain ID")
+)
+
+func init() {
+	cmjson.RegisterType(EventDataPreConfirmationBroken{}, "rollkit/event/PreConfirmationBroken")
+}
+
+// BrokenPreConfirmation is the evidence of sequencer not honoring a pre-confirmation.
+type BrokenPreConfirmation struct {
+	PreConfirmation types.PreConfirmation `json:"preconfirmation"`
+	// Height of the block in which pre-confirmation was found broken.
+	Height uint64 `json:"height"`
+	// Included is true if transaction was included, but too late.
+	Included bool `json:"included"`
+	// Time when broken pre-confirmation was detected.
+	Time time.Time `json:"time"`
+}
+
+// EventDataPreConfirmationBroken is published with EventPreConfirmationBroken.
+type EventDataPreConfirmationBroken struct {
+	TxHash   cmbytes.HexBytes `json:"tx_hash"`
+	Height   uint64           `json:"height"`
+	Included bool             `json:"included"`
+}
+
+// pendingPreConfirmations holds pre-confirmations that are not settled yet, keyed by transaction hash.
+type pendingPreConfirmations struct {
+	mtx     sync.Mutex
+	pending map[string]*types.PreConfirmation
+}
+
+// PreConfirm returns a pre-confirmation of the transaction with given hash, signed with the proposer key.
+//
+// Transaction is promised to be included after transactions already queued in the mempool, within
+// PreConfirmationWindow blocks.
+func (m *Manager) PreConfirm(txHash []byte) (*types.PreConfirmation, error) {
+	if !m.isProposer || m.standby.Load() {
+		return nil, ErrNotProposer
+	}
+	if m.conf.PreConfirmationWindow == 0 {
+		return nil, ErrPreConfirmationsDisabled
+	}
+
+	blocks := m.conf.PreConfirmationWindow
+	if m.mempool != nil && m.maxBlockBytes > 0 {
+		blocks += uint64(m.mempool.SizeBytes()) / m.maxBlockBytes
+	}
+	height := m.store.Height()
+	pc := &types.PreConfirmation{
+		ChainID:      m.genesis.ChainID,
+		TxHash:       txHash,
+		IssuedHeight: height,
+		Height:       height + blocks,
+		Expiry:       time.Now().Add(time.Duration(blocks) * m.maxBlockInterval()),
+	}
+	signature, err := m.proposerKey.Sign(pc.SignBytes())
+	if err != nil {
+		return nil, err
+	}
+	pc.Signature = signature
+	return pc, nil
+}
+
+// maxBlockInterval returns the longest expected time between blocks containing transactions.
+func (m *Manager) maxBlockInterval() time.Duration {
+	if m.conf.AdaptiveBlockTime {
+		return m.conf.MaxBlockTime
+	}
+	return m.conf.BlockTime
+}
+
+// AddPreConfirmation verifies the pre-confirmation and tracks it until it's settled by synced blocks.
+//
+// Blocks already synced are checked immediately.
+func (m *Manager) AddPreConfirmation(ctx context.Context, pc *types.PreConfirmation) error {
+	if pc.ChainID != m.genesis.ChainID {
+		return fmt.Errorf("%w: %s", ErrWrongChainID, pc.ChainID)
+	}
+	if len(m.genesis.Validators) == 0 {
+		return ErrNoValidatorsInGenesis
+	}
+	if err := pc.Verify(m.genesis.Validators[0].PubKey); err != nil {
+		return err
+	}
+
+	settled, err := m.settleWithSyncedBlocks(ctx, pc)
+	if err != nil || settled {
+		return err
+	}
+
+	m.preConfirmations.mtx.Lock()
+	defer m.preConfirmations.mtx.Unlock()
+	if m.preConfirmations.pending == nil {
+		m.preConfirmations.pending = make(map[string]*types.PreConfirmation)
+	}
+	m.preConfirmations.pending[string(pc.TxHash)] = pc
+	return m.savePendingPreConfirmations(ctx)
+}
+
+// settleWithSyncedBlocks checks pre-confirmation against blocks synced since it was issued, and returns true if it's
+// settled.
+func (m *Manager) settleWithSyncedBlocks(ctx context.Context, pc *types.PreConfirmation) (bool, error) {
+	for h := pc.IssuedHeight + 1; h <= m.store.Height(); h++ {
+		block, err := m.store.GetBlock(ctx, h)
+		if err != nil {
+			return false, err
+		}
+		if m.settlePreConfirmation(ctx, pc, block, blockTxHashes(block)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// loadPreConfirmations restores pending pre-confirmations saved before restart. Pre-confirmations are checked against
+// blocks synced since they were issued, as the node could stop before saving them after a block was synced.
+func (m *Manager) loadPreConfirmations(ctx context.Context) error {
+	raw, err := m.store.GetMetadata(ctx, PendingPreConfirmationsKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var saved []*types.PreConfirmation
+	if err := json.Unmarshal(raw, &saved); err != nil {
+		return err
+	}
+
+	m.preConfirmations.mtx.Lock()
+	defer m.preConfirmations.mtx.Unlock()
+	m.preConfirmations.pending = make(map[string]*types.PreConfirmation, len(saved))
+	for _, pc := range saved {
+		settled, err := m.settleWithSyncedBlocks(ctx, pc)
+		if err != nil {
+			return err
+		}
+		if !settled {
+			m.preConfirmations.pending[string(pc.TxHash)] = pc
+		}
+	}
+	if len(m.preConfirmations.pending) < len(saved) {
+		if err := m.savePendingPreConfirmations(ctx); err != nil {
+			m.logger.Error("failed to save pending pre-confirmations", "error", err)
+		}
+	}
+	return nil
+}
+
+// savePendingPreConfirmations persists pending pre-confirmations. It has to be called with preConfirmations.mtx held.
+func (m *Manager) savePendingPreConfirmations(ctx context.Context) error {
+	pending := make([]*types.PreConfirmation, 0, len(m.preConfirmations.pending))
+	for _, pc := range m.preConfirmations.pending {
+		pending = append(pending, pc)
+	}
+	raw, err := json.Marshal(pending)
+	if err != nil {
+		return err
+	}
+	return m.store.SetMetadata(ctx, PendingPreConfirmationsKey, raw)
+}
+
+// GetBrokenPreConfirmation returns evidence of sequencer not honoring pre-confirmation of given transaction.
+func (m *Manager) GetBrokenPreConfirmation(ctx context.Context, txHash []byte) (*BrokenPreConfirmation, error) {
+	raw, err := m.store.GetMetadata(ctx, brokenPreConfirmationKey(txHash))
+	if err != nil {
+		return nil, err
+	}
+	broken := new(BrokenPreConfirmation)
+	if err := json.Unmarshal(raw, broken); err != nil {
+		return nil, err
+	}
+	return broken, nil
+}
+
+// checkPreConfirmations verifies pending pre-confirmations against synced block.
+func (m *Manager) checkPreConfirmations(ctx context.Context, block *types.Block) {
+	m.preConfirmations.mtx.Lock()
+	defer m.preConfirmations.mtx.Unlock()
+	if len(m.preConfirmations.pending) == 0 {
+		return
+	}
+	txHashes := blockTxHashes(block)
+	settled := false
+	for key, pc := range m.preConfirmations.pending {
+		if m.settlePreConfirmation(ctx, pc, block, txHashes) {
+			delete(m.preConfirmations.pending, key)
+			settled = true
+		}
+	}
+	if settled {
+		if err := m.savePendingPreConfirmations(ctx); err != nil {
+			m.logger.Error("failed to save pending pre-confirmations", "error", err)
+		}
+	}
+}
+
+// settlePreConfirmation checks if pre-confirmation is honored or broken by the block, and returns true if it's
+// settled.
+func (m *Manager) settlePreConfirmation(ctx context.Context, pc *types.PreConfirmation, block *types.Block, txHashes [][]byte) bool {
+	late := block.Height() > pc.Height || block.Time().After(pc.Expiry)
+	included := false
+	for _, hash := range txHashes {
+		if bytes.Equal(hash, pc.TxHash) {
+			included = true
+			break
+		}
+	}
+	switch {
+	case included && !late:
+		return true
+	case included || late || block.Height() == pc.Height:
+		m.recordBrokenPreConfirmation(ctx, pc, block.Height(), included)
+		return true
+	}
+	return false
+}
+
+// recordBrokenPreConfirmation persists the evidence of sequencer misbehavior and notifies subscribers.
+func (m *Manager) recordBrokenPreConfirmation(ctx context.Context, pc *types.PreConfirmation, height uint64, included bool) {
+	m.logger.Error("sequencer misbehavior detected: pre-confirmation broken", "txHash", pc.TxHash, "promisedHeight", pc.Height, "height", height, "included", included)
+	m.metrics.BrokenPreConfirmations.Add(1)
+
+	broken := BrokenPreConfirmation{
+		PreConfirmation: *pc,
+		Height:          height,
+		Included:        included,
+		Time:            time.Now(),
+	}
+	raw, err := json.Marshal(broken)
+	if err != nil {
+		m.logger.Error("failed to encode broken pre-confirmation", "error", err)
+	} else if err := m.store.SetMetadata(ctx, brokenPreConfirmationKey(pc.TxHash), raw); err != nil {
+		m.logger.Error("failed to save broken pre-confirmation", "error", err)
+	}
+	if m.eventBus != nil {
+		data := EventDataPreConfirmationBroken{TxHash: pc.TxHash, Height: height, Included: included}
+		if err := m.eventBus.Publish(EventPreConfirmationBroken, data); err != nil {
+			m.logger.Error("failed to publish broken pre-confirmation event", "error", err)
+		}
+	}
+}
+
+func blockTxHashes(block *types.Block) [][]byte {
+	hashes := make([][]byte, len(block.Data.Txs))
+	for i, tx := range block.Data.Txs {
+		hashes[i] = tx.Hash()
+	}
+	return hashes
+}
+
+func brokenPreConfirmationKey(txHash []byte) string {
+	return BrokenPreConfirmationKey + "/" + hex.EncodeToString(txHash)
+}
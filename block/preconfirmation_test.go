@@ -0,0 +1,176 @@
+package block
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+func getPreConfirmationManager(t *testing.T) *Manager {
+	genesis, privKey := types.GetGenesisWithPrivkey()
+	signingKey, err := types.PrivKeyToSigningKey(privKey)
+	require.NoError(t, err)
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	eventBus := cmtypes.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() { _ = eventBus.Stop() })
+	return &Manager{
+		conf:         config.BlockManagerConfig{BlockTime: time.Second, PreConfirmationWindow: 2},
+		genesis:      genesis,
+		proposerKey:  signingKey,
+		isProposer:   true,
+		store:        store.New(kv),
+		logger:       test.NewLogger(t),
+		lastStateMtx: new(sync.RWMutex),
+		metrics:      NopMetrics(),
+		eventBus:     eventBus,
+	}
+}
+
+func getBlockWithTxs(height uint64, txs ...types.Tx) *types.Block {
+	block := types.GetRandomBlock(height, 0)
+	block.Data.Txs = txs
+	return block
+}
+
+func TestPreConfirm(t *testing.T) {
+	require := require.New(t)
+	m := getPreConfirmationManager(t)
+	m.store.SetHeight(context.Background(), 5)
+
+	tx := types.GetRandomTx()
+	pc, err := m.PreConfirm(tx.Hash())
+	require.NoError(err)
+	assert.Equal(t, uint64(5), pc.IssuedHeight)
+	assert.Equal(t, uint64(7), pc.Height)
+	assert.True(t, pc.Expiry.After(time.Now()))
+	require.NoError(pc.Verify(m.genesis.Validators[0].PubKey))
+
+	pc.Height++
+	require.ErrorIs(pc.Verify(m.genesis.Validators[0].PubKey), types.ErrPreConfirmationSignature)
+
+	m.conf.PreConfirmationWindow = 0
+	_, err = m.PreConfirm(tx.Hash())
+	require.ErrorIs(err, ErrPreConfirmationsDisabled)
+}
+
+func TestCheckPreConfirmations(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m := getPreConfirmationManager(t)
+	sub, err := m.eventBus.Subscribe(ctx, "test", cmtypes.QueryForEvent(EventPreConfirmationBroken))
+	require.NoError(err)
+
+	kept, broken := types.GetRandomTx(), types.GetRandomTx()
+	keptPC, err := m.PreConfirm(kept.Hash())
+	require.NoError(err)
+	brokenPC, err := m.PreConfirm(broken.Hash())
+	require.NoError(err)
+
+	// full node verifies pre-confirmations against synced blocks
+	require.NoError(m.AddPreConfirmation(ctx, keptPC))
+	require.NoError(m.AddPreConfirmation(ctx, brokenPC))
+
+	m.checkPreConfirmations(ctx, getBlockWithTxs(1, kept))
+	m.checkPreConfirmations(ctx, getBlockWithTxs(2))
+	assert.Empty(t, m.preConfirmations.pending)
+
+	_, err = m.GetBrokenPreConfirmation(ctx, kept.Hash())
+	require.Error(err)
+	evidence, err := m.GetBrokenPreConfirmation(ctx, broken.Hash())
+	require.NoError(err)
+	assert.Equal(t, uint64(2), evidence.Height)
+	assert.False(t, evidence.Included)
+
+	select {
+	case msg := <-sub.Out():
+		data, ok := msg.Data().(EventDataPreConfirmationBroken)
+		require.True(ok)
+		assert.Equal(t, broken.Hash(), []byte(data.TxHash))
+	case <-time.After(time.Second):
+		t.Fatal("broken pre-confirmation event not published")
+	}
+}
+
+func TestAddPreConfirmation(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m := getPreConfirmationManager(t)
+
+	tx := types.GetRandomTx()
+	pc, err := m.PreConfirm(tx.Hash())
+	require.NoError(err)
+
+	invalid := *pc
+	invalid.Signature = []byte("invalid")
+	require.ErrorIs(m.AddPreConfirmation(ctx, &invalid), types.ErrPreConfirmationSignature)
+	invalid = *pc
+	invalid.ChainID = "other"
+	require.ErrorIs(m.AddPreConfirmation(ctx, &invalid), ErrWrongChainID)
+
+	// transaction included too late, in already synced blocks
+	for h := uint64(1); h <= 3; h++ {
+		block := getBlockWithTxs(h)
+		if h == 3 {
+			block = getBlockWithTxs(h, tx)
+		}
+		require.NoError(m.store.SaveBlock(ctx, block, &block.SignedHeader.Commit))
+		m.store.SetHeight(ctx, h)
+	}
+	require.NoError(m.AddPreConfirmation(ctx, pc))
+	assert.Empty(t, m.preConfirmations.pending)
+	evidence, err := m.GetBrokenPreConfirmation(ctx, tx.Hash())
+	require.NoError(err)
+	assert.Equal(t, uint64(2), evidence.Height)
+	assert.False(t, evidence.Included)
+}
+
+func TestLoadPreConfirmations(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m := getPreConfirmationManager(t)
+
+	kept, broken := types.GetRandomTx(), types.GetRandomTx()
+	keptPC, err := m.PreConfirm(kept.Hash())
+	require.NoError(err)
+	brokenPC, err := m.PreConfirm(broken.Hash())
+	require.NoError(err)
+	require.NoError(m.AddPreConfirmation(ctx, keptPC))
+	require.NoError(m.AddPreConfirmation(ctx, brokenPC))
+
+	// node is restarted after syncing blocks, before pending pre-confirmations are checked against them
+	for h := uint64(1); h <= 2; h++ {
+		block := getBlockWithTxs(h)
+		if h == 1 {
+			block = getBlockWithTxs(h, kept)
+		}
+		require.NoError(m.store.SaveBlock(ctx, block, &block.SignedHeader.Commit))
+	}
+	restarted := getPreConfirmationManager(t)
+	restarted.store = m.store
+	require.NoError(restarted.loadPreConfirmations(ctx))
+	assert.Len(t, restarted.preConfirmations.pending, 2)
+
+	restarted.store.SetHeight(ctx, 2)
+	require.NoError(restarted.loadPreConfirmations(ctx))
+	assert.Empty(t, restarted.preConfirmations.pending)
+	_, err = restarted.GetBrokenPreConfirmation(ctx, kept.Hash())
+	require.Error(err)
+	_, err = restarted.GetBrokenPreConfirmation(ctx, broken.Hash())
+	require.NoError(err)
+
+	// settled pre-confirmations are removed from the store
+	require.NoError(restarted.loadPreConfirmations(ctx))
+	assert.Empty(t, restarted.preConfirmations.pending)
+}
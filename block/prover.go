@@ -0,0 +1,285 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/rollkit/rollkit/da"
+	"github.com/rollkit/rollkit/types"
+)
+
+// LastProvenHeightKey is the key used for persisting the height of the last block with generated validity proof.
+const LastProvenHeightKey = "last proven"
+
+// LastSubmittedProofHeightKey is the key used for persisting the height of the last validity proof submitted to DA.
+const LastSubmittedProofHeightKey = "last submitted proof"
+
+// proofCacheWindow is the maximum distance above the current height of validity proofs kept in cache.
+const proofCacheWindow = 1000
+
+var (
+	// ErrProofNotAvailable is returned when validity proof for a block was not retrieved yet.
+	ErrProofNotAvailable = errors.New("validity proof not available")
+
+	// ErrProofMismatch is returned when validity proof doesn't reference the block being synced.
+	ErrProofMismatch = errors.New("validity proof doesn't match block")
+
+	// ErrNoVerifier is returned when validity proofs are required, but no Verifier is configured.
+	ErrNoVerifier = errors.New("validity proofs are required, but no verifier is configured")
+)
+
+// Prover generates validity proofs of block execution.
+//
+// Prover is invoked asynchronously, after block is committed, so proving doesn't delay block production.
+type Prover interface {
+	// Prove generates a proof of correct execution of the given block.
+	Prove(ctx context.Context, block *types.Block) ([]byte, error)
+}
+
+// Verifier checks validity proofs generated by Prover.
+type Verifier interface {
+	// Verify returns nil if proof attests to correct execution of the given block.
+	Verify(ctx context.Context, block *types.Block, proof []byte) error
+}
+
+// SetProver is used to set Prover used by Manager to generate validity proofs for produced blocks.
+func (m *Manager) SetProver(prover Prover) {
+	m.prover = prover
+}
+
+// SetVerifier is used to set Verifier used by Manager to check validity proofs of synced blocks.
+func (m *Manager) SetVerifier(verifier Verifier) {
+	m.verifier = verifier
+}
+
+// ProofLoop is responsible for generating validity proofs for committed blocks.
+func (m *Manager) ProofLoop(ctx context.Context) {
+	if m.prover == nil {
+		return
+	}
+	// catch up with blocks committed before restart
+	m.sendNonBlockingSignalToProofCh()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.proofCh:
+		}
+		if err := m.proveBlocks(ctx); err != nil && ctx.Err() == nil {
+			m.logger.Error("failed to generate validity proof", "error", err)
+		}
+	}
+}
+
+func (m *Manager) sendNonBlockingSignalToProofCh() {
+	select {
+	case m.proofCh <- struct{}{}:
+	default:
+	}
+}
+
+// proveBlocks generates and stores validity proofs for all committed blocks that weren't proven yet.
+func (m *Manager) proveBlocks(ctx context.Context) error {
+	lastProven, err := m.getHeightMetadata(ctx, LastProvenHeightKey)
+	if err != nil {
+		return err
+	}
+	for height := lastProven + 1; height <= m.store.Height(); height++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		block, err := m.store.GetBlock(ctx, height)
+		if err != nil {
+			return fmt.Errorf("failed to load block at height %d: %w", height, err)
+		}
+		proof, err := m.prover.Prove(ctx, block)
+		if err != nil {
+			return fmt.Errorf("failed to prove block at height %d: %w", height, err)
+		}
+		err = m.store.SaveValidityProof(ctx, &types.ValidityProof{
+			Height:    height,
+			BlockHash: block.Hash(),
+			Proof:     proof,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save validity proof: %w", err)
+		}
+		if err := m.setHeightMetadata(ctx, LastProvenHeightKey, height); err != nil {
+			return err
+		}
+		m.logger.Debug("generated validity proof", "height", height)
+	}
+	return nil
+}
+
+// submitProofsToDA submits stored validity proofs that were not yet posted to DA.
+func (m *Manager) submitProofsToDA(ctx context.Context) error {
+	lastSubmitted, err := m.getHeightMetadata(ctx, LastSubmittedProofHeightKey)
+	if err != nil {
+		return err
+	}
+	lastProven, err := m.getHeightMetadata(ctx, LastProvenHeightKey)
+	if err != nil {
+		return err
+	}
+	if lastSubmitted >= lastProven {
+		return nil
+	}
+	proofs := make([]*types.ValidityProof, 0, lastProven-lastSubmitted)
+	for height := lastSubmitted + 1; height <= lastProven; height++ {
+		proof, err := m.store.GetValidityProof(ctx, height)
+		if err != nil {
+			return fmt.Errorf("failed to load validity proof at height %d: %w", height, err)
+		}
+		proofs = append(proofs, proof)
+	}
+	maxBlobSize, err := m.dalc.DA.MaxBlobSize(ctx)
+	if err != nil {
+		return err
+	}
+	res := m.dalc.SubmitProofs(ctx, proofs, maxBlobSize, m.dalc.GasPrice)
+	if res.Code != da.StatusSuccess {
+		return fmt.Errorf("failed to submit validity proofs: %s", res.Message)
+	}
+	if res.SubmittedCount == 0 || res.SubmittedCount > uint64(len(proofs)) {
+		return fmt.Errorf("unexpected number of submitted validity proofs: %d of %d", res.SubmittedCount, len(proofs))
+	}
+	m.logger.Info("successfully submitted validity proofs to DA layer", "daHeight", res.DAHeight, "count", res.SubmittedCount)
+	return m.setHeightMetadata(ctx, LastSubmittedProofHeightKey, proofs[res.SubmittedCount-1].Height)
+}
+
+// cacheProofs caches validity proofs retrieved from given DA height until blocks are synced.
+//
+// Only proofs for heights within proofCacheWindow above the current height are cached, to bound memory usage.
+// Proofs are not cached at all if they are not required by configuration.
+func (m *Manager) cacheProofs(proofs []*types.ValidityProof, daHeight uint64) {
+	if !m.conf.RequireProofs {
+		return
+	}
+	height := m.store.Height()
+	m.proofCache.Range(func(key, _ any) bool {
+		if key.(uint64) <= height {
+			m.proofCache.Delete(key)
+		}
+		return true
+	})
+	for _, proof := range proofs {
+		if proof.Height <= height {
+			continue
+		}
+		if proof.Height > height+proofCacheWindow {
+			m.logger.Debug("dropping validity proof too far ahead", "height", proof.Height, "daHeight", daHeight)
+			continue
+		}
+		m.proofCache.Store(proof.Height, proof)
+		m.logger.Debug("validity proof retrieved", "height", proof.Height, "daHeight", daHeight)
+	}
+	if len(proofs) > 0 {
+		m.sendNonBlockingSignalToProofInCh()
+	}
+}
+
+func (m *Manager) sendNonBlockingSignalToProofInCh() {
+	select {
+	case m.proofInCh <- struct{}{}:
+	default:
+	}
+}
+
+// verifyProof checks validity proof of the block, if proofs are required by configuration.
+//
+// ErrProofNotAvailable is returned if proof for the block wasn't retrieved yet.
+func (m *Manager) verifyProof(ctx context.Context, block *types.Block) error {
+	if !m.conf.RequireProofs {
+		return nil
+	}
+	if m.verifier == nil {
+		return ErrNoVerifier
+	}
+	height := block.Height()
+	cached, ok := m.proofCache.Load(height)
+	if !ok {
+		return ErrProofNotAvailable
+	}
+	proof := cached.(*types.ValidityProof)
+	if !bytes.Equal(proof.BlockHash, block.Hash()) {
+		m.proofCache.Delete(height)
+		return fmt.Errorf("%w: height %d, expected hash %s, got %s", ErrProofMismatch, height, block.Hash(), proof.BlockHash)
+	}
+	if err := m.verifier.Verify(ctx, block, proof.Proof); err != nil {
+		m.proofCache.Delete(height)
+		return err
+	}
+	if err := m.store.SaveValidityProof(ctx, proof); err != nil {
+		return fmt.Errorf("failed to save validity proof: %w", err)
+	}
+	m.proofCache.Delete(height)
+	return nil
+}
+
+func (m *Manager) getHeightMetadata(ctx context.Context, key string) (uint64, error) {
+	raw, err := m.store.GetMetadata(ctx, key)
+	if errors.Is(err, ds.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(string(raw), 10, 64)
+}
+
+func (m *Manager) setHeightMetadata(ctx context.Context, key string, height uint64) error {
+	return m.store.SetMetadata(ctx, key, []byte(strconv.FormatUint(height, 10)))
+}
+
+// MockProver is a Prover and Verifier that doesn't prove anything.
+//
+// Proof is a digest of block height and hash. It's intended for testing and local development only.
+type MockProver struct {
+	proven atomic.Uint64
+}
+
+var _ Prover = &MockProver{}
+var _ Verifier = &MockProver{}
+
+// NewMockProver creates new MockProver.
+func NewMockProver() *MockProver {
+	return &MockProver{}
+}
+
+// Prove returns mock proof of the block.
+func (p *MockProver) Prove(_ context.Context, block *types.Block) ([]byte, error) {
+	p.proven.Add(1)
+	return mockProof(block), nil
+}
+
+// Verify checks if proof is a mock proof of the block.
+func (p *MockProver) Verify(_ context.Context, block *types.Block, proof []byte) error {
+	if !bytes.Equal(proof, mockProof(block)) {
+		return fmt.Errorf("invalid mock proof for block at height %d", block.Height())
+	}
+	return nil
+}
+
+// Proven returns the number of blocks proven by MockProver.
+func (p *MockProver) Proven() uint64 {
+	return p.proven.Load()
+}
+
+func mockProof(block *types.Block) []byte {
+	h := sha256.New()
+	h.Write([]byte("mock validity proof"))
+	_ = binary.Write(h, binary.BigEndian, block.Height())
+	h.Write(block.Hash())
+	return h.Sum(nil)
+}
@@ -0,0 +1,170 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/da/mock"
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+func getProverManager(t *testing.T, conf config.BlockManagerConfig) *Manager {
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	return &Manager{
+		conf:    conf,
+		store:   store.New(kv),
+		logger:  test.NewLogger(t),
+		proofCh: make(chan struct{}, 1),
+	}
+}
+
+func TestProveBlocks(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	m := getProverManager(t, config.BlockManagerConfig{})
+	prover := NewMockProver()
+	m.SetProver(prover)
+
+	blocks := make([]*types.Block, 3)
+	for i := range blocks {
+		blocks[i] = types.GetRandomBlock(uint64(i+1), 2)
+		require.NoError(m.store.SaveBlock(ctx, blocks[i], &blocks[i].SignedHeader.Commit))
+	}
+	m.store.SetHeight(ctx, 2)
+
+	require.NoError(m.proveBlocks(ctx))
+	require.EqualValues(2, prover.Proven())
+
+	m.store.SetHeight(ctx, 3)
+	require.NoError(m.proveBlocks(ctx))
+	require.EqualValues(3, prover.Proven())
+
+	lastProven, err := m.getHeightMetadata(ctx, LastProvenHeightKey)
+	require.NoError(err)
+	require.EqualValues(3, lastProven)
+
+	for _, block := range blocks {
+		proof, err := m.store.GetValidityProof(ctx, block.Height())
+		require.NoError(err)
+		assert.Equal(t, block.Hash(), proof.BlockHash)
+		assert.NoError(t, prover.Verify(ctx, block, proof.Proof))
+	}
+}
+
+func TestVerifyProof(t *testing.T) {
+	ctx := context.Background()
+	block := types.GetRandomBlock(1, 2)
+	prover := NewMockProver()
+	validProof, err := prover.Prove(ctx, block)
+	require.NoError(t, err)
+
+	t.Run("proofs not required", func(t *testing.T) {
+		m := getProverManager(t, config.BlockManagerConfig{})
+		assert.NoError(t, m.verifyProof(ctx, block))
+	})
+
+	t.Run("no verifier", func(t *testing.T) {
+		m := getProverManager(t, config.BlockManagerConfig{RequireProofs: true})
+		assert.ErrorIs(t, m.verifyProof(ctx, block), ErrNoVerifier)
+	})
+
+	t.Run("proof not available", func(t *testing.T) {
+		m := getProverManager(t, config.BlockManagerConfig{RequireProofs: true})
+		m.SetVerifier(prover)
+		assert.ErrorIs(t, m.verifyProof(ctx, block), ErrProofNotAvailable)
+	})
+
+	t.Run("block hash mismatch", func(t *testing.T) {
+		m := getProverManager(t, config.BlockManagerConfig{RequireProofs: true})
+		m.SetVerifier(prover)
+		m.proofCache.Store(uint64(1), &types.ValidityProof{Height: 1, BlockHash: types.GetRandomBytes(32), Proof: validProof})
+		assert.ErrorIs(t, m.verifyProof(ctx, block), ErrProofMismatch)
+		assert.ErrorIs(t, m.verifyProof(ctx, block), ErrProofNotAvailable)
+	})
+
+	t.Run("invalid proof", func(t *testing.T) {
+		m := getProverManager(t, config.BlockManagerConfig{RequireProofs: true})
+		m.SetVerifier(prover)
+		m.proofCache.Store(uint64(1), &types.ValidityProof{Height: 1, BlockHash: block.Hash(), Proof: []byte("invalid")})
+		assert.Error(t, m.verifyProof(ctx, block))
+	})
+
+	t.Run("valid proof", func(t *testing.T) {
+		m := getProverManager(t, config.BlockManagerConfig{RequireProofs: true})
+		m.SetVerifier(prover)
+		m.proofCache.Store(uint64(1), &types.ValidityProof{Height: 1, BlockHash: block.Hash(), Proof: validProof})
+		require.NoError(t, m.verifyProof(ctx, block))
+
+		stored, err := m.store.GetValidityProof(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, validProof, stored.Proof)
+	})
+}
+
+func TestCacheProofs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("proofs not required", func(t *testing.T) {
+		m := getProverManager(t, config.BlockManagerConfig{})
+		m.cacheProofs([]*types.ValidityProof{{Height: 1}}, 1)
+		_, ok := m.proofCache.Load(uint64(1))
+		assert.False(t, ok)
+	})
+
+	t.Run("window", func(t *testing.T) {
+		m := getProverManager(t, config.BlockManagerConfig{RequireProofs: true})
+		m.store.SetHeight(ctx, 10)
+		m.cacheProofs([]*types.ValidityProof{
+			{Height: 10},
+			{Height: 11},
+			{Height: 10 + proofCacheWindow},
+			{Height: 11 + proofCacheWindow},
+		}, 1)
+
+		cached := func(height uint64) bool {
+			_, ok := m.proofCache.Load(height)
+			return ok
+		}
+		assert.False(t, cached(10))
+		assert.True(t, cached(11))
+		assert.True(t, cached(10+proofCacheWindow))
+		assert.False(t, cached(11+proofCacheWindow))
+
+		// proofs for synced heights are pruned
+		m.store.SetHeight(ctx, 11)
+		m.cacheProofs(nil, 2)
+		assert.False(t, cached(11))
+		assert.True(t, cached(10+proofCacheWindow))
+	})
+}
+
+func TestSubmitProofsUnexpectedCount(t *testing.T) {
+	ctx := context.Background()
+	mockDA := &mock.MockDA{}
+	m := getManager(t, mockDA)
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	m.store = store.New(kv)
+
+	block := types.GetRandomBlock(1, 2)
+	require.NoError(t, m.store.SaveValidityProof(ctx, &types.ValidityProof{Height: 1, BlockHash: block.Hash(), Proof: []byte("proof")}))
+	require.NoError(t, m.setHeightMetadata(ctx, LastProvenHeightKey, 1))
+
+	mockDA.On("MaxBlobSize").Return(uint64(12345), nil)
+	mockDA.On("Submit", testifymock.Anything, testifymock.Anything, testifymock.Anything).
+		Return([][]byte{make([]byte, 8), make([]byte, 8)}, nil)
+
+	assert.Error(t, m.submitProofsToDA(ctx))
+	lastSubmitted, err := m.getHeightMetadata(ctx, LastSubmittedProofHeightKey)
+	require.NoError(t, err)
+	assert.Zero(t, lastSubmitted)
+}
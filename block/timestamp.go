@@ -0,0 +1,54 @@
+package block
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// ErrTimeAheadOfDA is returned when block time is ahead of the time of DA block including it by more than MaxDATimeDrift.
+var ErrTimeAheadOfDA = errors.New("block time ahead of DA block time")
+
+// checkBlockTime verifies that the block time is strictly increasing and not too far in the future, both according to
+// the local clock and to the time of DA block including it (if known).
+//
+// Block time is compared with the previous block, if it's already synced or cached. Blocks rejected only because they
+// are ahead of the local clock (types.ErrTimeTooFarInFuture) are valid once the local clock catches up, so they are
+// kept in the block cache and synced when their time is reached (see trySyncNextBlock).
+func (m *Manager) checkBlockTime(event NewBlockEvent) error {
+	block := event.Block
+	blockTime := block.Time()
+	if drift := time.Until(blockTime); drift > types.MaxClockDrift {
+		return fmt.Errorf("%w: %s ahead of local clock", types.ErrTimeTooFarInFuture, drift)
+	}
+	if err := m.checkDATimeDrift(block, event.DAHeight, event.DATime); err != nil {
+		return err
+	}
+
+	height := block.Height()
+	storeHeight := m.store.Height()
+	var lastTime time.Time
+	if storeHeight > 0 && height == storeHeight+1 {
+		lastTime = m.getLastBlockTime()
+	} else if prev, ok := m.blockCache.getBlock(height - 1); ok {
+		lastTime = prev.Time()
+	}
+	if !lastTime.IsZero() && !blockTime.After(lastTime) {
+		return fmt.Errorf("%w: previous block time %s, block time %s", types.ErrNonMonotonicTime, lastTime, blockTime)
+	}
+	return nil
+}
+
+// checkDATimeDrift verifies that the block time is not ahead of the time of DA block including it by more than
+// MaxDATimeDrift. Nothing is checked if the time of DA block is not known.
+func (m *Manager) checkDATimeDrift(block *types.Block, daHeight uint64, daTime time.Time) error {
+	if m.conf.MaxDATimeDrift <= 0 || daTime.IsZero() {
+		return nil
+	}
+	if drift := block.Time().Sub(daTime); drift > m.conf.MaxDATimeDrift {
+		return fmt.Errorf("%w: %s ahead of DA block at height %d", ErrTimeAheadOfDA, drift, daHeight)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package block
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+func getBlockWithTime(height uint64, blockTime time.Time) *types.Block {
+	block := types.GetRandomBlock(height, 0)
+	block.SignedHeader.BaseHeader.Time = uint64(blockTime.UnixNano())
+	return block
+}
+
+func TestCheckBlockTime(t *testing.T) {
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	now := time.Now()
+	m := &Manager{
+		conf:         config.BlockManagerConfig{MaxDATimeDrift: time.Second},
+		store:        store.New(kv),
+		blockCache:   NewBlockCache(),
+		lastState:    types.State{LastBlockHeight: 1, LastBlockTime: now.Add(-time.Minute)},
+		lastStateMtx: new(sync.RWMutex),
+	}
+	m.store.SetHeight(context.Background(), 1)
+	m.blockCache.setBlock(2, getBlockWithTime(2, now.Add(-30*time.Second)))
+
+	cases := []struct {
+		name  string
+		event NewBlockEvent
+		err   error
+	}{
+		{"next block", NewBlockEvent{Block: getBlockWithTime(2, now.Add(-30*time.Second))}, nil},
+		{"same time as last block", NewBlockEvent{Block: getBlockWithTime(2, now.Add(-time.Minute))}, types.ErrNonMonotonicTime},
+		{"before last block", NewBlockEvent{Block: getBlockWithTime(2, now.Add(-2*time.Minute))}, types.ErrNonMonotonicTime},
+		{"after cached block", NewBlockEvent{Block: getBlockWithTime(3, now.Add(-20*time.Second))}, nil},
+		{"before cached block", NewBlockEvent{Block: getBlockWithTime(3, now.Add(-40*time.Second))}, types.ErrNonMonotonicTime},
+		{"no previous block", NewBlockEvent{Block: getBlockWithTime(5, now.Add(-time.Hour))}, nil},
+		{"too far in the future", NewBlockEvent{Block: getBlockWithTime(2, now.Add(2*types.MaxClockDrift))}, types.ErrTimeTooFarInFuture},
+		{"within DA drift", NewBlockEvent{Block: getBlockWithTime(2, now), DAHeight: 10, DATime: now.Add(-500 * time.Millisecond)}, nil},
+		{"ahead of DA", NewBlockEvent{Block: getBlockWithTime(2, now), DAHeight: 10, DATime: now.Add(-2 * time.Second)}, ErrTimeAheadOfDA},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := m.checkBlockTime(c.event)
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, c.err)
+			}
+		})
+	}
+
+	m.conf.MaxDATimeDrift = 0
+	assert.NoError(t, m.checkBlockTime(NewBlockEvent{Block: getBlockWithTime(2, now), DATime: now.Add(-time.Hour)}))
+}
+
+func TestMarkDAIncludedAheadOfDA(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	now := time.Now()
+	m := getDivergenceManager(t, config.BlockManagerConfig{MaxDATimeDrift: time.Second})
+
+	// block received from P2P network, without DA time
+	synced := getBlockWithTime(1, now)
+	m.blockCache.setBlock(1, synced)
+
+	require.ErrorIs(m.markDAIncluded(ctx, synced, 10, now.Add(-2*time.Second)), ErrTimeAheadOfDA)
+	require.False(m.blockCache.isDAIncluded(synced.Hash().String()))
+
+	require.NoError(m.markDAIncluded(ctx, synced, 11, now.Add(-500*time.Millisecond)))
+	require.True(m.blockCache.isDAIncluded(synced.Hash().String()))
+}
+
+func TestBlockAheadOfLocalClock(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	genesis, privKey := types.GetGenesisWithPrivkey()
+	m := getDivergenceManager(t, config.BlockManagerConfig{})
+	m.genesis = genesis
+
+	block, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1, PrivKey: privKey})
+	block.SignedHeader.BaseHeader.Time = uint64(time.Now().Add(2 * types.MaxClockDrift).UnixNano())
+	commit, err := types.GetCommit(block.SignedHeader.Header, privKey)
+	require.NoError(err)
+	block.SignedHeader.Commit = *commit
+
+	// valid once the local clock catches up, so it's accepted from DA and deferred
+	require.True(m.isUsingExpectedCentralizedSequencer(block))
+	require.ErrorIs(m.checkBlockTime(NewBlockEvent{Block: block}), types.ErrTimeTooFarInFuture)
+	m.blockCache.setBlock(1, block)
+	require.NoError(m.trySyncNextBlock(ctx, 0))
+	require.Equal(uint64(0), m.store.Height())
+	_, ok := m.blockCache.getBlock(1)
+	require.True(ok)
+
+	// invalid signature is reported before the time
+	block.SignedHeader.Commit.Signatures[0] = types.GetRandomBytes(64)
+	require.False(m.isUsingExpectedCentralizedSequencer(block))
+}
@@ -0,0 +1,37 @@
+package block
+
+import (
+	cmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/rollkit/rollkit/mempool"
+	"github.com/rollkit/rollkit/types"
+)
+
+// SetTxStatusTracker is used to set tracker notified when transactions are included in blocks and in DA layer.
+func (m *Manager) SetTxStatusTracker(tracker *mempool.TxStatusTracker) {
+	m.txStatus = tracker
+}
+
+// trackIncluded records that transactions from the block were included at the block height.
+func (m *Manager) trackIncluded(block *types.Block) {
+	if m.txStatus == nil {
+		return
+	}
+	m.txStatus.Included(toCMTxs(block.Data.Txs), block.Height())
+}
+
+// trackDAIncluded records that transactions from the block were included in DA layer at daHeight.
+func (m *Manager) trackDAIncluded(block *types.Block, daHeight uint64) {
+	if m.txStatus == nil {
+		return
+	}
+	m.txStatus.DAIncluded(toCMTxs(block.Data.Txs), block.Height(), daHeight)
+}
+
+func toCMTxs(txs types.Txs) cmtypes.Txs {
+	cmTxs := make(cmtypes.Txs, len(txs))
+	for i, tx := range txs {
+		cmTxs[i] = cmtypes.Tx(tx)
+	}
+	return cmTxs
+}
@@ -0,0 +1,197 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	ds "github.com/ipfs/go-datastore"
+	ktds "github.com/ipfs/go-datastore/keytransform"
+	dsq "github.com/ipfs/go-datastore/query"
+	"github.com/spf13/cobra"
+
+	rollconf "github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/store"
+)
+
+const (
+	// nodeDBName is the name of the database of the full node, see node.initBaseKV.
+	nodeDBName = "rollkit"
+	// nodeMainPrefix is the prefix of the main node data in the database, see node.mainPrefix.
+	nodeMainPrefix = "0"
+)
+
+// NewDBCmd creates a new cobra command group for node database operations.
+func NewDBCmd() *cobra.Command {
+	dbCmd := &cobra.Command{
+		Use:   "db",
+		Short: "Node database operations",
+		Long:  `This command group is used to manage the node database.`,
+		Example: `  rollkit db migrate --from badger --to pebble
+  rollkit db upgrade --dry-run`,
+	}
+
+	dbCmd.AddCommand(newMigrateCmd())
+	dbCmd.AddCommand(newUpgradeCmd())
+
+	return dbCmd
+}
+
+func newMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Copy node database to a different backend",
+		Long: `This command copies all the data of the node (blocks, state, DA and indexer data) from the database using one
+backend to a new database using another backend. The node must be stopped. After migration, start the node with
+--rollkit.db_backend set to the new backend; the old database is left intact.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseConfig(cmd); err != nil {
+				return err
+			}
+			from, err := getBackend(cmd, "from")
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetString("to")
+			if err != nil {
+				return err
+			}
+			if from == to {
+				return errors.New("source and destination backends must be different")
+			}
+
+			src, err := openNodeKVStore(from)
+			if err != nil {
+				return err
+			}
+			defer src.Close() //nolint:errcheck
+			dst, err := store.NewKVStore(to, config.RootDir, config.DBPath, nodeDBName)
+			if err != nil {
+				return fmt.Errorf("failed to open %s database: %w", to, err)
+			}
+			defer dst.Close() //nolint:errcheck
+
+			results, err := dst.Query(cmd.Context(), dsq.Query{KeysOnly: true, Limit: 1})
+			if err != nil {
+				return err
+			}
+			existing, err := results.Rest()
+			if err != nil {
+				return err
+			}
+			if len(existing) > 0 {
+				return fmt.Errorf("destination database %s is not empty", store.KVStorePath(to, config.RootDir, config.DBPath, nodeDBName))
+			}
+
+			copied, err := store.CopyKVStore(cmd.Context(), src, dst)
+			if err != nil {
+				return fmt.Errorf("migration failed after copying %d entries: %w", copied, err)
+			}
+			fmt.Printf("Copied %d entries from %s to %s database %s\n", copied, from, to, store.KVStorePath(to, config.RootDir, config.DBPath, nodeDBName))
+			return nil
+		},
+	}
+	addBackendFlag(cmd, "from", "source database backend")
+	cmd.Flags().String("to", store.PebbleBackend, "destination database backend (badger | pebble | leveldb)")
+	return cmd
+}
+
+func newUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade node database schema",
+		Long: `This command applies pending store migrations, upgrading the node database to the schema version supported by
+this version of rollkit. Migrations are also applied automatically when the node is started. The node must be stopped.
+With --dry-run, pending migrations are only listed.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseConfig(cmd); err != nil {
+				return err
+			}
+			backend, err := getBackend(cmd, "backend")
+			if err != nil {
+				return err
+			}
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return err
+			}
+
+			s, err := openNodeStore(backend, false)
+			if err != nil {
+				return err
+			}
+			defer s.Close() //nolint:errcheck
+
+			version, err := store.GetSchemaVersion(cmd.Context(), s)
+			if err != nil {
+				return err
+			}
+			migrations, err := store.Migrate(cmd.Context(), s, dryRun)
+			for _, m := range migrations {
+				fmt.Printf("%d: %s\n", m.Version, m.Description)
+			}
+			if err != nil {
+				return err
+			}
+			if dryRun {
+				fmt.Printf("Schema version %d, %d pending migrations to version %d\n", version, len(migrations), store.SchemaVersion)
+			} else {
+				fmt.Printf("Upgraded schema from version %d to %d\n", version, store.SchemaVersion)
+			}
+			return nil
+		},
+	}
+	addBackendFlag(cmd, "backend", "database backend")
+	cmd.Flags().Bool("dry-run", false, "only list pending migrations")
+	return cmd
+}
+
+// addBackendFlag registers a flag selecting a database backend, which defaults to the backend configured for the node.
+func addBackendFlag(cmd *cobra.Command, name, usage string) {
+	cmd.Flags().String(name, "", fmt.Sprintf("%s (badger | pebble | leveldb), defaults to %s of the node", usage, rollconf.FlagDBBackend))
+}
+
+// getBackend returns the database backend selected by given flag, or the backend configured for the node if the flag
+// is not set. It must be called after parseConfig.
+func getBackend(cmd *cobra.Command, name string) (string, error) {
+	backend, err := cmd.Flags().GetString(name)
+	if err != nil || backend != "" {
+		return backend, err
+	}
+	return nodeConfig.DBBackend, nil
+}
+
+// openNodeKVStore opens the existing database of the full node using given backend.
+//
+// Backends create a new database if it doesn't exist, so the path is checked first, to not operate on an empty
+// database when the node uses a different backend or home directory.
+func openNodeKVStore(backend string) (ds.TxnDatastore, error) {
+	path := store.KVStorePath(backend, config.RootDir, config.DBPath, nodeDBName)
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("failed to open %s database: %w", backend, err)
+	}
+	kv, err := store.NewKVStore(backend, config.RootDir, config.DBPath, nodeDBName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s database: %w", backend, err)
+	}
+	return kv, nil
+}
+
+// openNodeStore opens the main store of the full node using given backend. If create is false, the database must
+// already exist.
+func openNodeStore(backend string, create bool) (store.Store, error) {
+	var kv ds.TxnDatastore
+	var err error
+	if create {
+		kv, err = store.NewKVStore(backend, config.RootDir, config.DBPath, nodeDBName)
+		if err != nil {
+			err = fmt.Errorf("failed to open %s database: %w", backend, err)
+		}
+	} else {
+		kv, err = openNodeKVStore(backend)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return store.New(ktds.Wrap(kv, ktds.PrefixTransform{Prefix: ds.NewKey(nodeMainPrefix)}).Children()[0].(ds.TxnDatastore)), nil
+}
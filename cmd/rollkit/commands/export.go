@@ -0,0 +1,163 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	comettypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+
+	"github.com/rollkit/rollkit/store"
+)
+
+// NewExportCmd creates a command that exports the chain of the node to a file.
+func NewExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export blocks and state to a file",
+		Long: `This command writes blocks (with commits and block responses) from the given height range and the final state of
+the node to a portable file, that can be loaded with "rollkit import". The state is exported only if the range ends at
+the last block. The node must be stopped.`,
+		Example: `  rollkit export --out chain.export
+  rollkit export --from 100 --to 200 --out chain.export`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseConfig(cmd); err != nil {
+				return err
+			}
+			backend, err := getBackend(cmd, "backend")
+			if err != nil {
+				return err
+			}
+			from, err := cmd.Flags().GetUint64("from")
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetUint64("to")
+			if err != nil {
+				return err
+			}
+			out, err := cmd.Flags().GetString("out")
+			if err != nil {
+				return err
+			}
+
+			s, err := openNodeStore(backend, false)
+			if err != nil {
+				return err
+			}
+			defer s.Close() //nolint:errcheck
+
+			state, err := s.GetState(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to load state: %w", err)
+			}
+			if from == 0 {
+				from = state.InitialHeight
+			}
+			if to == 0 {
+				to = state.LastBlockHeight
+			}
+
+			f, err := os.Create(out)
+			if err != nil {
+				return err
+			}
+			if err := store.Export(cmd.Context(), s, f, from, to); err != nil {
+				_ = f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			fmt.Printf("Exported blocks %d-%d to %s\n", from, to, out)
+			return nil
+		},
+	}
+	addBackendFlag(cmd, "backend", "database backend")
+	cmd.Flags().Uint64("from", 0, "first exported height (default initial height)")
+	cmd.Flags().Uint64("to", 0, "last exported height (default last block height)")
+	cmd.Flags().String("out", "", "output file")
+	_ = cmd.MarkFlagRequired("out")
+	return cmd
+}
+
+// NewImportCmd creates a command that imports the chain exported with "rollkit export" into an empty node store.
+func NewImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import blocks and state from a file",
+		Long: `This command loads blocks and state written by "rollkit export" into the empty store of the node. All the checksums,
+block signatures and hash links between the blocks are verified. The first block must be proposed by the sequencer
+from the genesis file of the node or, with --trusted-hash, have the given hash. The node must be stopped.`,
+		Example: `  rollkit import --in chain.export`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseConfig(cmd); err != nil {
+				return err
+			}
+			backend, err := getBackend(cmd, "backend")
+			if err != nil {
+				return err
+			}
+			in, err := cmd.Flags().GetString("in")
+			if err != nil {
+				return err
+			}
+			trust, err := getImportTrust(cmd)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(in)
+			if err != nil {
+				return err
+			}
+			defer f.Close() //nolint:errcheck
+
+			s, err := openNodeStore(backend, true)
+			if err != nil {
+				return err
+			}
+			defer s.Close() //nolint:errcheck
+
+			result, err := store.Import(cmd.Context(), s, f, trust)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Imported blocks %d-%d from %s\n", result.FromHeight, result.ToHeight, in)
+			if !result.StateImported {
+				fmt.Println("Warning: export doesn't contain the state, node can't be started from the imported blocks")
+			}
+			return nil
+		},
+	}
+	addBackendFlag(cmd, "backend", "database backend")
+	cmd.Flags().String("in", "", "input file")
+	cmd.Flags().String("trusted-hash", "", "trusted hash of the first imported block (hex), instead of the sequencer from genesis")
+	_ = cmd.MarkFlagRequired("in")
+	return cmd
+}
+
+// getImportTrust returns the root of trust for the imported chain: the hash from --trusted-hash flag if it's set, or
+// the sequencer from the genesis file of the node.
+func getImportTrust(cmd *cobra.Command) (store.ImportTrust, error) {
+	trustedHash, err := cmd.Flags().GetString("trusted-hash")
+	if err != nil {
+		return store.ImportTrust{}, err
+	}
+	if trustedHash != "" {
+		hash, err := hex.DecodeString(trustedHash)
+		if err != nil {
+			return store.ImportTrust{}, fmt.Errorf("invalid trusted hash: %w", err)
+		}
+		return store.ImportTrust{Hash: hash}, nil
+	}
+	genDoc, err := comettypes.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return store.ImportTrust{}, fmt.Errorf("failed to read genesis file (use --trusted-hash to import without it): %w", err)
+	}
+	if len(genDoc.Validators) != 1 {
+		return store.ImportTrust{}, fmt.Errorf("expected exactly one sequencer in genesis, got %d", len(genDoc.Validators))
+	}
+	return store.ImportTrust{ProposerAddress: genDoc.Validators[0].Address}, nil
+}
@@ -22,17 +22,20 @@ import (
 	comettime "github.com/cometbft/cometbft/types/time"
 
 	proxy "github.com/rollkit/go-da/proxy/jsonrpc"
-	goDATest "github.com/rollkit/go-da/test"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	rollconf "github.com/rollkit/rollkit/config"
+	damock "github.com/rollkit/rollkit/da/mock"
 	rollnode "github.com/rollkit/rollkit/node"
 	rollrpc "github.com/rollkit/rollkit/rpc"
 	rolltypes "github.com/rollkit/rollkit/types"
 )
 
+// flagRPCOnly is a shorthand for rollconf.FlagRPCOnly
+const flagRPCOnly = "rpc-only"
+
 var (
 	// initialize the config with the cometBFT defaults
 	config = cometconf.DefaultConfig()
@@ -117,6 +120,47 @@ func NewRunNodeCmd() *cobra.Command {
 				nodeConfig.LazyAggregator = lazyAgg.Value.String() == "true"
 			}
 
+			if dbBackend := cmd.Flags().Lookup(rollconf.FlagDBBackend); dbBackend.Changed {
+				nodeConfig.DBBackend = dbBackend.Value.String()
+			}
+			if cmd.Flags().Lookup(rollconf.FlagStoreCacheSize).Changed {
+				cacheSize, err := cmd.Flags().GetInt(rollconf.FlagStoreCacheSize)
+				if err != nil {
+					return err
+				}
+				nodeConfig.StoreCacheSize = cacheSize
+			}
+			if cmd.Flags().Lookup(rollconf.FlagDBGCInterval).Changed {
+				if nodeConfig.DBGCInterval, err = cmd.Flags().GetDuration(rollconf.FlagDBGCInterval); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Lookup(rollconf.FlagDBGCDiscardRatio).Changed {
+				if nodeConfig.DBGCDiscardRatio, err = cmd.Flags().GetFloat64(rollconf.FlagDBGCDiscardRatio); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Lookup(rollconf.FlagDBDiskUsageAlert).Changed {
+				if nodeConfig.DBDiskUsageAlert, err = cmd.Flags().GetUint64(rollconf.FlagDBDiskUsageAlert); err != nil {
+					return err
+				}
+			}
+
+			rpcOnly, err := cmd.Flags().GetBool(flagRPCOnly)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Lookup(rollconf.FlagRPCOnly).Changed {
+				if rpcOnly, err = cmd.Flags().GetBool(rollconf.FlagRPCOnly); err != nil {
+					return err
+				}
+			}
+			if rpcOnly {
+				nodeConfig.RPCOnly = true
+				// rpc-only node serves data of another node, so it never produces blocks
+				nodeConfig.Aggregator = false
+			}
+
 			// use mock jsonrpc da server by default
 			if !cmd.Flags().Lookup("rollkit.da_address").Changed {
 				srv, err := startMockDAServJSONRPC(cmd.Context())
@@ -209,6 +253,7 @@ func addNodeFlags(cmd *cobra.Command) {
 
 	cmd.Flags().String("transport", config.ABCI, "specify abci transport (socket | grpc)")
 	cmd.Flags().Bool("ci", false, "run node for ci testing")
+	cmd.Flags().Bool(flagRPCOnly, false, "serve RPC queries from read-only snapshot of database of another node (shorthand for --"+rollconf.FlagRPCOnly+")")
 
 	// Add Rollkit flags
 	rollconf.AddFlags(cmd)
@@ -217,7 +262,7 @@ func addNodeFlags(cmd *cobra.Command) {
 // startMockDAServJSONRPC starts a mock JSONRPC server
 func startMockDAServJSONRPC(ctx context.Context) (*proxy.Server, error) {
 	addr, _ := url.Parse(nodeConfig.DAAddress)
-	srv := proxy.NewServer(addr.Hostname(), addr.Port(), goDATest.NewDummyDA())
+	srv := proxy.NewServer(addr.Hostname(), addr.Port(), damock.NewDummyDA())
 	err := srv.Start(ctx)
 	if err != nil {
 		return nil, err
@@ -305,5 +350,11 @@ func parseConfig(cmd *cobra.Command) error {
 	if err := config.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in config file: %w", err)
 	}
+
+	// Database backend is also used by commands operating on the node database, which don't register rollkit flags,
+	// so it's read from viper (flag, RK_ROLLKIT_DB_BACKEND environment variable or config file)
+	if backend := viper.GetString(rollconf.FlagDBBackend); backend != "" {
+		nodeConfig.DBBackend = backend
+	}
 	return nil
 }
@@ -0,0 +1,125 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/rollkit/rollkit/store"
+)
+
+// NewStoreCmd creates a new cobra command group for node store inspection.
+func NewStoreCmd() *cobra.Command {
+	storeCmd := &cobra.Command{
+		Use:   "store",
+		Short: "Node store inspection",
+		Long:  `This command group is used to inspect the blocks and state saved by the node.`,
+		Example: `  rollkit store verify --repair
+  rollkit store stats`,
+	}
+
+	storeCmd.AddCommand(newVerifyCmd())
+	storeCmd.AddCommand(newStatsCmd())
+
+	return storeCmd
+}
+
+func newVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify integrity of the node store",
+		Long: `This command walks all the blocks up to the last block of the state and checks the height to hash index, block
+signatures, data hashes, hash links between the blocks, commits, presence of block responses and consistency of the
+state. With --repair, broken index entries are rebuilt from the saved blocks. The node must be stopped.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseConfig(cmd); err != nil {
+				return err
+			}
+			backend, err := getBackend(cmd, "backend")
+			if err != nil {
+				return err
+			}
+			repair, err := cmd.Flags().GetBool("repair")
+			if err != nil {
+				return err
+			}
+
+			s, err := openNodeStore(backend, false)
+			if err != nil {
+				return err
+			}
+			defer s.Close() //nolint:errcheck
+
+			report, err := s.(*store.DefaultStore).Verify(cmd.Context(), repair)
+			if err != nil {
+				return err
+			}
+			for _, issue := range report.Issues {
+				status := ""
+				if issue.Repaired {
+					status = " (repaired)"
+				}
+				if issue.Height == 0 {
+					fmt.Printf("state: %v%s\n", issue.Err, status)
+				} else {
+					fmt.Printf("height %d: %v%s\n", issue.Height, issue.Err, status)
+				}
+			}
+			fmt.Printf("Verified blocks %d-%d: %d issues, %d repaired\n", report.FromHeight, report.ToHeight, len(report.Issues), report.Repaired())
+			if len(report.Issues) > report.Repaired() {
+				return errors.New("store verification failed")
+			}
+			return nil
+		},
+	}
+	addBackendFlag(cmd, "backend", "database backend")
+	cmd.Flags().Bool("repair", false, "rebuild broken height to hash index entries")
+	return cmd
+}
+
+func newStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show disk usage of the node database",
+		Long: `This command shows the size of the node database on disk, and the number of keys and the total size of keys and
+values of each component of the node: main store (blocks and state), header and block stores of the sync services,
+DA layer client, indexers, evidence pool and other data (e.g. P2P). The node must be stopped. The same data is exposed by a running node as store_disk_usage_bytes,
+store_data_size_bytes and store_data_keys metrics, updated every --rollkit.db_gc_interval.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseConfig(cmd); err != nil {
+				return err
+			}
+			backend, err := getBackend(cmd, "backend")
+			if err != nil {
+				return err
+			}
+
+			kv, err := openNodeKVStore(backend)
+			if err != nil {
+				return err
+			}
+			defer kv.Close() //nolint:errcheck
+
+			stats, err := store.GetStats(cmd.Context(), kv)
+			if err != nil {
+				return err
+			}
+			components := make([]string, 0, len(stats.Components))
+			for component := range stats.Components {
+				components = append(components, component)
+			}
+			sort.Strings(components)
+			fmt.Printf("%-14s %12s %16s\n", "COMPONENT", "KEYS", "SIZE (BYTES)")
+			for _, component := range components {
+				c := stats.Components[component]
+				fmt.Printf("%-14s %12d %16d\n", component, c.Keys, c.Size)
+			}
+			fmt.Printf("Disk usage of %s database %s: %d bytes\n", backend, store.KVStorePath(backend, config.RootDir, config.DBPath, nodeDBName), stats.DiskUsage)
+			return nil
+		},
+	}
+	addBackendFlag(cmd, "backend", "database backend")
+	return cmd
+}
@@ -21,6 +21,10 @@ func main() {
 		cmd.NewRunNodeCmd(),
 		cmd.VersionCmd,
 		cmd.NewTomlCmd(),
+		cmd.NewDBCmd(),
+		cmd.NewExportCmd(),
+		cmd.NewImportCmd(),
+		cmd.NewStoreCmd(),
 	)
 
 	// In case there is a rollkit.toml file in the current dir or somewhere up the
@@ -36,6 +36,46 @@ const (
 	FlagLazyAggregator = "rollkit.lazy_aggregator"
 	// FlagMaxPendingBlocks is a flag to pause aggregator in case of large number of blocks pending DA submission
 	FlagMaxPendingBlocks = "rollkit.max_pending_blocks"
+	// FlagAdaptiveBlockTime is a flag for enabling block time adjusted to mempool load
+	FlagAdaptiveBlockTime = "rollkit.adaptive_block_time"
+	// FlagMinBlockTime is a flag for specifying the minimal block time in adaptive mode
+	FlagMinBlockTime = "rollkit.min_block_time"
+	// FlagMaxBlockTime is a flag for specifying the maximal block time in adaptive mode
+	FlagMaxBlockTime = "rollkit.max_block_time"
+	// FlagHaltOnDivergence is a flag for halting the node when sequencer publishes conflicting blocks
+	FlagHaltOnDivergence = "rollkit.halt_on_divergence"
+	// FlagSequencerHeaderTimeout is a flag for specifying time without new headers after which sequencer is considered stalled
+	FlagSequencerHeaderTimeout = "rollkit.sequencer_header_timeout"
+	// FlagSequencerDATimeout is a flag for specifying time without blocks on DA after which sequencer is considered stalled
+	FlagSequencerDATimeout = "rollkit.sequencer_da_timeout"
+	// FlagRequireProofs is a flag for requiring verified validity proofs before applying synced blocks
+	FlagRequireProofs = "rollkit.require_proofs"
+	// FlagPreConfirmationWindow is a flag for specifying number of blocks within which pre-confirmed transactions are included
+	FlagPreConfirmationWindow = "rollkit.preconfirmation_window"
+	// FlagLeaseFile is a flag for specifying the file holding aggregator lease in hot-standby mode
+	FlagLeaseFile = "rollkit.lease_file"
+	// FlagLeaseTTL is a flag for specifying how long aggregator lease is valid without renewal
+	FlagLeaseTTL = "rollkit.lease_ttl"
+	// FlagExecutorAddress is a flag for specifying the address of remote executor
+	FlagExecutorAddress = "rollkit.executor_address"
+	// FlagOptimisticExecution is a flag for executing synced blocks in the background, while waiting for validity proofs
+	FlagOptimisticExecution = "rollkit.optimistic_execution"
+	// FlagMaxDATimeDrift is a flag for specifying how far block time can be ahead of the time of DA block including it
+	FlagMaxDATimeDrift = "rollkit.max_da_time_drift"
+	// FlagConsensusHashHeight is a flag for specifying the height from which ConsensusHash of synced blocks is checked
+	FlagConsensusHashHeight = "rollkit.consensus_hash_height"
+	// FlagDBBackend is a flag for specifying the database backend
+	FlagDBBackend = "rollkit.db_backend"
+	// FlagStoreCacheSize is a flag for specifying the number of cached blocks, commits and block responses
+	FlagStoreCacheSize = "rollkit.store_cache_size"
+	// FlagRPCOnly is a flag for serving RPC queries from a read-only store, without syncing
+	FlagRPCOnly = "rollkit.rpc_only"
+	// FlagDBGCInterval is a flag for specifying how often database garbage collection is run
+	FlagDBGCInterval = "rollkit.db_gc_interval"
+	// FlagDBGCDiscardRatio is a flag for specifying the minimal fraction of stale data in a value log file to rewrite it
+	FlagDBGCDiscardRatio = "rollkit.db_gc_discard_ratio"
+	// FlagDBDiskUsageAlert is a flag for specifying the database size in bytes above which an alert is logged
+	FlagDBDiskUsageAlert = "rollkit.db_disk_usage_alert"
 )
 
 // NodeConfig stores Rollkit node configuration.
@@ -56,6 +96,23 @@ type NodeConfig struct {
 	Instrumentation    *cmcfg.InstrumentationConfig `mapstructure:"instrumentation"`
 	DAGasPrice         float64                      `mapstructure:"da_gas_price"`
 	DAGasMultiplier    float64                      `mapstructure:"da_gas_multiplier"`
+	// LeaseFile enables hot-standby mode, with aggregator lease stored in the file shared by all aggregators.
+	LeaseFile string `mapstructure:"lease_file"`
+	// ExecutorAddress is the address of gRPC server of remote executor. If empty, ABCI application is used.
+	ExecutorAddress string `mapstructure:"executor_address"`
+	// DBBackend is the database backend used by the node: badger, pebble or leveldb.
+	DBBackend string `mapstructure:"db_backend"`
+	// StoreCacheSize is the number of recently used blocks, commits and block responses cached in memory, 0 disables cache.
+	StoreCacheSize int `mapstructure:"store_cache_size"`
+	// RPCOnly makes node serve RPC queries from a read-only snapshot of the store written by another node (e.g. a copy
+	// of its database directory), without P2P networking, syncing or block production.
+	RPCOnly bool `mapstructure:"rpc_only"`
+	// DBGCInterval defines how often database garbage collection is run and database size is reported, 0 disables it.
+	DBGCInterval time.Duration `mapstructure:"db_gc_interval"`
+	// DBGCDiscardRatio is the minimal fraction of stale data in a badger value log file for the file to be rewritten.
+	DBGCDiscardRatio float64 `mapstructure:"db_gc_discard_ratio"`
+	// DBDiskUsageAlert is the database size in bytes above which an alert is logged, 0 disables alerts.
+	DBDiskUsageAlert uint64 `mapstructure:"db_disk_usage_alert"`
 
 	// CLI flags
 	DANamespace string `mapstructure:"da_namespace"`
@@ -82,6 +139,35 @@ type BlockManagerConfig struct {
 	// LazyBlockTime defines how often new blocks are produced in lazy mode
 	// even if there are no transactions
 	LazyBlockTime time.Duration `mapstructure:"lazy_block_time"`
+	// AdaptiveBlockTime enables adjusting block time to mempool load, between MinBlockTime and MaxBlockTime.
+	AdaptiveBlockTime bool `mapstructure:"adaptive_block_time"`
+	// MinBlockTime defines how often new blocks are produced in adaptive mode when mempool is full
+	MinBlockTime time.Duration `mapstructure:"min_block_time"`
+	// MaxBlockTime defines how often new blocks are produced in adaptive mode when mempool is idle
+	MaxBlockTime time.Duration `mapstructure:"max_block_time"`
+	// HaltOnDivergence halts the node when sequencer publishes conflicting blocks at the same height.
+	// Otherwise sequencer is only flagged as faulty.
+	HaltOnDivergence bool `mapstructure:"halt_on_divergence"`
+	// SequencerHeaderTimeout defines how long full node waits for a new header before considering sequencer stalled.
+	SequencerHeaderTimeout time.Duration `mapstructure:"sequencer_header_timeout"`
+	// SequencerDATimeout defines how long full node waits for a new block on DA before considering sequencer stalled.
+	SequencerDATimeout time.Duration `mapstructure:"sequencer_da_timeout"`
+	// RequireProofs makes full nodes wait for a verified validity proof before applying a block.
+	RequireProofs bool `mapstructure:"require_proofs"`
+	// PreConfirmationWindow is the number of blocks, in addition to already queued transactions, within which
+	// aggregator promises to include accepted transactions. Zero disables pre-confirmations.
+	PreConfirmationWindow uint64 `mapstructure:"preconfirmation_window"`
+	// LeaseTTL defines how long aggregator lease is valid without renewal in hot-standby mode.
+	LeaseTTL time.Duration `mapstructure:"lease_ttl"`
+	// OptimisticExecution makes full nodes execute synced blocks in the background as soon as they're validated, while
+	// waiting for their validity proofs, and commit them once both are done. It's useful only with RequireProofs.
+	OptimisticExecution bool `mapstructure:"optimistic_execution"`
+	// MaxDATimeDrift is the maximal difference by which block time can be ahead of the time of DA block including it.
+	// Zero disables the check. It's used only if DA layer provides block times.
+	MaxDATimeDrift time.Duration `mapstructure:"max_da_time_drift"`
+	// ConsensusHashHeight is the height from which ConsensusHash of synced blocks has to match consensus params. Blocks
+	// below it may have zero ConsensusHash, set by sequencers before it committed to consensus params.
+	ConsensusHashHeight uint64 `mapstructure:"consensus_hash_height"`
 }
 
 // GetNodeConfig translates Tendermint's configuration into Rollkit configuration.
@@ -129,6 +215,26 @@ func (nc *NodeConfig) GetViperConfig(v *viper.Viper) error {
 	nc.TrustedHash = v.GetString(FlagTrustedHash)
 	nc.TrustedHash = v.GetString(FlagTrustedHash)
 	nc.MaxPendingBlocks = v.GetUint64(FlagMaxPendingBlocks)
+	nc.AdaptiveBlockTime = v.GetBool(FlagAdaptiveBlockTime)
+	nc.MinBlockTime = v.GetDuration(FlagMinBlockTime)
+	nc.MaxBlockTime = v.GetDuration(FlagMaxBlockTime)
+	nc.HaltOnDivergence = v.GetBool(FlagHaltOnDivergence)
+	nc.SequencerHeaderTimeout = v.GetDuration(FlagSequencerHeaderTimeout)
+	nc.SequencerDATimeout = v.GetDuration(FlagSequencerDATimeout)
+	nc.RequireProofs = v.GetBool(FlagRequireProofs)
+	nc.PreConfirmationWindow = v.GetUint64(FlagPreConfirmationWindow)
+	nc.LeaseFile = v.GetString(FlagLeaseFile)
+	nc.LeaseTTL = v.GetDuration(FlagLeaseTTL)
+	nc.ExecutorAddress = v.GetString(FlagExecutorAddress)
+	nc.OptimisticExecution = v.GetBool(FlagOptimisticExecution)
+	nc.MaxDATimeDrift = v.GetDuration(FlagMaxDATimeDrift)
+	nc.ConsensusHashHeight = v.GetUint64(FlagConsensusHashHeight)
+	nc.DBBackend = v.GetString(FlagDBBackend)
+	nc.StoreCacheSize = v.GetInt(FlagStoreCacheSize)
+	nc.RPCOnly = v.GetBool(FlagRPCOnly)
+	nc.DBGCInterval = v.GetDuration(FlagDBGCInterval)
+	nc.DBGCDiscardRatio = v.GetFloat64(FlagDBGCDiscardRatio)
+	nc.DBDiskUsageAlert = v.GetUint64(FlagDBDiskUsageAlert)
 	return nil
 }
 
@@ -150,4 +256,24 @@ func AddFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagLight, def.Light, "run light client")
 	cmd.Flags().String(FlagTrustedHash, def.TrustedHash, "initial trusted hash to start the header exchange service")
 	cmd.Flags().Uint64(FlagMaxPendingBlocks, def.MaxPendingBlocks, "limit of blocks pending DA submission (0 for no limit)")
+	cmd.Flags().Bool(FlagAdaptiveBlockTime, def.AdaptiveBlockTime, "adjust block time to mempool load (for aggregator mode)")
+	cmd.Flags().Duration(FlagMinBlockTime, def.MinBlockTime, "minimal block time in adaptive mode")
+	cmd.Flags().Duration(FlagMaxBlockTime, def.MaxBlockTime, "maximal block time in adaptive mode")
+	cmd.Flags().Bool(FlagHaltOnDivergence, def.HaltOnDivergence, "halt the node when sequencer publishes conflicting blocks at the same height")
+	cmd.Flags().Duration(FlagSequencerHeaderTimeout, def.SequencerHeaderTimeout, "time without new headers after which sequencer is considered stalled (for syncing)")
+	cmd.Flags().Duration(FlagSequencerDATimeout, def.SequencerDATimeout, "time without new blocks on DA after which sequencer is considered stalled (for syncing)")
+	cmd.Flags().Bool(FlagRequireProofs, def.RequireProofs, "apply synced blocks only after their validity proof is verified")
+	cmd.Flags().Uint64(FlagPreConfirmationWindow, def.PreConfirmationWindow, "number of blocks within which aggregator promises to include accepted transactions (0 disables signed pre-confirmations)")
+	cmd.Flags().String(FlagLeaseFile, def.LeaseFile, "file holding aggregator lease, shared by aggregators in hot-standby mode (empty disables hot-standby mode)")
+	cmd.Flags().Duration(FlagLeaseTTL, def.LeaseTTL, "time after which aggregator lease can be taken over if not renewed (for hot-standby mode)")
+	cmd.Flags().String(FlagExecutorAddress, def.ExecutorAddress, "remote executor gRPC address (host:port), empty to execute transactions with ABCI application")
+	cmd.Flags().Bool(FlagOptimisticExecution, def.OptimisticExecution, "execute synced blocks in the background, while waiting for their validity proofs (for syncing)")
+	cmd.Flags().Duration(FlagMaxDATimeDrift, def.MaxDATimeDrift, "maximal time by which block can be ahead of DA block including it, 0 disables the check (for syncing)")
+	cmd.Flags().Uint64(FlagConsensusHashHeight, def.ConsensusHashHeight, "height from which ConsensusHash of synced blocks is checked, blocks below it may have legacy zero ConsensusHash (for syncing chains started with older versions)")
+	cmd.Flags().String(FlagDBBackend, def.DBBackend, "database backend (badger | pebble | leveldb)")
+	cmd.Flags().Int(FlagStoreCacheSize, def.StoreCacheSize, "number of recently used blocks, commits and block responses cached in memory (0 disables cache)")
+	cmd.Flags().Bool(FlagRPCOnly, def.RPCOnly, "serve RPC queries from read-only snapshot of database of another node, without syncing (reopened when the snapshot is replaced)")
+	cmd.Flags().Duration(FlagDBGCInterval, def.DBGCInterval, "how often database garbage collection is run and database size is reported (0 disables it)")
+	cmd.Flags().Float64(FlagDBGCDiscardRatio, def.DBGCDiscardRatio, "minimal fraction of stale data in a badger value log file to rewrite it during garbage collection")
+	cmd.Flags().Uint64(FlagDBDiskUsageAlert, def.DBDiskUsageAlert, "database size in bytes above which an alert is logged (0 disables alerts)")
 }
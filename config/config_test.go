@@ -50,6 +50,13 @@ func TestViperAndCobra(t *testing.T) {
 	assert.NoError(cmd.Flags().Set(FlagDAAddress, `{"json":true}`))
 	assert.NoError(cmd.Flags().Set(FlagBlockTime, "1234s"))
 	assert.NoError(cmd.Flags().Set(FlagDANamespace, "0102030405060708"))
+	assert.NoError(cmd.Flags().Set(FlagDBBackend, "pebble"))
+	assert.NoError(cmd.Flags().Set(FlagStoreCacheSize, "50"))
+	assert.NoError(cmd.Flags().Set(FlagRPCOnly, "true"))
+	assert.NoError(cmd.Flags().Set(FlagDBGCInterval, "1h"))
+	assert.NoError(cmd.Flags().Set(FlagDBGCDiscardRatio, "0.3"))
+	assert.NoError(cmd.Flags().Set(FlagDBDiskUsageAlert, "1073741824"))
+	assert.NoError(cmd.Flags().Set(FlagConsensusHashHeight, "1000"))
 
 	nc := DefaultNodeConfig
 	assert.NoError(nc.GetViperConfig(v))
@@ -57,4 +64,11 @@ func TestViperAndCobra(t *testing.T) {
 	assert.Equal(true, nc.Aggregator)
 	assert.Equal(`{"json":true}`, nc.DAAddress)
 	assert.Equal(1234*time.Second, nc.BlockTime)
+	assert.Equal("pebble", nc.DBBackend)
+	assert.Equal(50, nc.StoreCacheSize)
+	assert.Equal(true, nc.RPCOnly)
+	assert.Equal(time.Hour, nc.DBGCInterval)
+	assert.Equal(0.3, nc.DBGCDiscardRatio)
+	assert.Equal(uint64(1073741824), nc.DBDiskUsageAlert)
+	assert.Equal(uint64(1000), nc.ConsensusHashHeight)
 }
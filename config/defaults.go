@@ -26,6 +26,8 @@ var DefaultNodeConfig = NodeConfig{
 		BlockTime:     1 * time.Second,
 		DABlockTime:   15 * time.Second,
 		LazyBlockTime: 60 * time.Second,
+		MinBlockTime:  100 * time.Millisecond,
+		MaxBlockTime:  10 * time.Second,
 	},
 	DAAddress:       "http://localhost:26658",
 	DAGasPrice:      -1,
@@ -34,5 +36,9 @@ var DefaultNodeConfig = NodeConfig{
 	HeaderConfig: HeaderConfig{
 		TrustedHash: "",
 	},
-	Instrumentation: config.DefaultInstrumentationConfig(),
+	Instrumentation:  config.DefaultInstrumentationConfig(),
+	DBBackend:        "badger",
+	StoreCacheSize:   1000,
+	DBGCInterval:     15 * time.Minute,
+	DBGCDiscardRatio: 0.5,
 }
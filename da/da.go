@@ -1,6 +1,7 @@
 package da
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"errors"
@@ -24,6 +25,10 @@ const (
 	defaultRetrieveTimeout = 60 * time.Second
 )
 
+// proofBlobPrefix tags blobs containing validity proofs. Field number 0 is invalid in protobuf, so blocks, posted
+// without any tag, never start with it.
+var proofBlobPrefix = []byte("\x00rollkit-proof")
+
 var (
 	// ErrBlobNotFound is used to indicate that the blob was not found.
 	ErrBlobNotFound = errors.New("blob: not found")
@@ -50,6 +55,12 @@ var (
 	ErrContextDeadline = errors.New("context deadline")
 )
 
+// TimestampDA is an optional interface of DA layers able to return the time of DA block.
+type TimestampDA interface {
+	// GetTimestamp returns the time of DA block at given height, or zero time if it's not known.
+	GetTimestamp(ctx context.Context, height uint64) (time.Time, error)
+}
+
 // StatusCode is a type for DA layer return status.
 // TODO: define an enum of different non-happy-path cases
 // that might need to be handled by Rollkit independent of
@@ -94,6 +105,15 @@ type ResultRetrieveBlocks struct {
 	// Block is the full block retrieved from Data Availability Layer.
 	// If Code is not equal to StatusSuccess, it has to be nil.
 	Blocks []*types.Block
+	// Timestamp is the time of DA block. It's zero if DA layer doesn't implement TimestampDA.
+	Timestamp time.Time
+	// Proofs are the validity proofs posted in the same DA block.
+	Proofs []*types.ValidityProof
+}
+
+// ResultSubmitProofs contains information returned from DA layer after validity proofs submission.
+type ResultSubmitProofs struct {
+	BaseResult
 }
 
 // DAClient is a new DA implementation.
@@ -151,6 +171,50 @@ func (dac *DAClient) SubmitBlocks(ctx context.Context, blocks []*types.Block, ma
 		}
 	}
 
+	return ResultSubmitBlocks{
+		BaseResult: dac.submit(ctx, blobs, gasPrice, "blocks"),
+	}
+}
+
+// SubmitProofs submits validity proofs to DA.
+//
+// Proofs are posted in the same namespace as blocks, each blob is tagged with proofBlobPrefix to distinguish it from
+// blocks during retrieval.
+func (dac *DAClient) SubmitProofs(ctx context.Context, proofs []*types.ValidityProof, maxBlobSize uint64, gasPrice float64) ResultSubmitProofs {
+	var (
+		blobs    [][]byte
+		blobSize uint64
+	)
+	for i := range proofs {
+		proof, err := proofs[i].MarshalBinary()
+		if err != nil {
+			dac.Logger.Info("failed to serialize validity proof", "error", err)
+			break
+		}
+		blob := append(proofBlobPrefix[:len(proofBlobPrefix):len(proofBlobPrefix)], proof...)
+		if blobSize+uint64(len(blob)) > maxBlobSize {
+			dac.Logger.Info(ErrBlobSizeOverLimit.Error(), "maxBlobSize", maxBlobSize, "index", i, "blobSize", blobSize, "len(blob)", len(blob))
+			break
+		}
+		blobSize += uint64(len(blob))
+		blobs = append(blobs, blob)
+	}
+	if len(blobs) == 0 {
+		return ResultSubmitProofs{
+			BaseResult: BaseResult{
+				Code:    StatusError,
+				Message: "failed to submit proofs: no blobs generated",
+			},
+		}
+	}
+
+	return ResultSubmitProofs{
+		BaseResult: dac.submit(ctx, blobs, gasPrice, "proofs"),
+	}
+}
+
+// submit posts blobs to DA and translates DA specific errors into status codes.
+func (dac *DAClient) submit(ctx context.Context, blobs [][]byte, gasPrice float64, what string) BaseResult {
 	ctx, cancel := context.WithTimeout(ctx, dac.SubmitTimeout)
 	defer cancel()
 	ids, err := dac.DA.Submit(ctx, blobs, gasPrice, dac.Namespace)
@@ -169,79 +233,57 @@ func (dac *DAClient) SubmitBlocks(ctx context.Context, blocks []*types.Block, ma
 		case strings.Contains(err.Error(), ErrContextDeadline.Error()):
 			status = StatusContextDeadline
 		}
-		return ResultSubmitBlocks{
-			BaseResult: BaseResult{
-				Code:    status,
-				Message: "failed to submit blocks: " + err.Error(),
-			},
+		return BaseResult{
+			Code:    status,
+			Message: fmt.Sprintf("failed to submit %s: %s", what, err.Error()),
 		}
 	}
 
 	if len(ids) == 0 {
-		return ResultSubmitBlocks{
-			BaseResult: BaseResult{
-				Code:    StatusError,
-				Message: "failed to submit blocks: unexpected len(ids): 0",
-			},
+		return BaseResult{
+			Code:    StatusError,
+			Message: fmt.Sprintf("failed to submit %s: unexpected len(ids): 0", what),
 		}
 	}
 
-	return ResultSubmitBlocks{
-		BaseResult: BaseResult{
-			Code:           StatusSuccess,
-			DAHeight:       binary.LittleEndian.Uint64(ids[0]),
-			SubmittedCount: uint64(len(ids)),
-		},
+	return BaseResult{
+		Code:           StatusSuccess,
+		DAHeight:       binary.LittleEndian.Uint64(ids[0]),
+		SubmittedCount: uint64(len(ids)),
 	}
 }
 
-// RetrieveBlocks retrieves blocks from DA.
+// RetrieveBlocks retrieves blocks and validity proofs from DA.
 func (dac *DAClient) RetrieveBlocks(ctx context.Context, dataLayerHeight uint64) ResultRetrieveBlocks {
-	ids, err := dac.DA.GetIDs(ctx, dataLayerHeight, dac.Namespace)
-	if err != nil {
-		return ResultRetrieveBlocks{
-			BaseResult: BaseResult{
-				Code:     StatusError,
-				Message:  fmt.Sprintf("failed to get IDs: %s", err.Error()),
-				DAHeight: dataLayerHeight,
-			},
-		}
-	}
-
-	// If no blocks are found, return a non-blocking error.
-	if len(ids) == 0 {
-		return ResultRetrieveBlocks{
-			BaseResult: BaseResult{
-				Code:     StatusNotFound,
-				Message:  ErrBlobNotFound.Error(),
-				DAHeight: dataLayerHeight,
-			},
-		}
-	}
-
-	ctx, cancel := context.WithTimeout(ctx, dac.RetrieveTimeout)
-	defer cancel()
-	blobs, err := dac.DA.Get(ctx, ids, dac.Namespace)
-	if err != nil {
-		return ResultRetrieveBlocks{
-			BaseResult: BaseResult{
-				Code:     StatusError,
-				Message:  fmt.Sprintf("failed to get blobs: %s", err.Error()),
-				DAHeight: dataLayerHeight,
-			},
-		}
+	blobs, res := dac.retrieve(ctx, dataLayerHeight)
+	if res.Code != StatusSuccess {
+		return ResultRetrieveBlocks{BaseResult: res}
 	}
 
-	blocks := make([]*types.Block, len(blobs))
+	blocks := make([]*types.Block, 0, len(blobs))
+	var proofs []*types.ValidityProof
 	for i, blob := range blobs {
-		var block pb.Block
-		err = proto.Unmarshal(blob, &block)
+		if bytes.HasPrefix(blob, proofBlobPrefix) {
+			proof := new(types.ValidityProof)
+			if err := proof.UnmarshalBinary(blob[len(proofBlobPrefix):]); err != nil {
+				dac.Logger.Error("failed to unmarshal validity proof", "daHeight", dataLayerHeight, "position", i, "error", err)
+				continue
+			}
+			if err := proof.ValidateBasic(); err != nil {
+				dac.Logger.Error("invalid validity proof", "daHeight", dataLayerHeight, "position", i, "error", err)
+				continue
+			}
+			proofs = append(proofs, proof)
+			continue
+		}
+		var pBlock pb.Block
+		err := proto.Unmarshal(blob, &pBlock)
 		if err != nil {
 			dac.Logger.Error("failed to unmarshal block", "daHeight", dataLayerHeight, "position", i, "error", err)
 			continue
 		}
-		blocks[i] = new(types.Block)
-		err := blocks[i].FromProto(&block)
+		block := new(types.Block)
+		err = block.FromProto(&pBlock)
 		if err != nil {
 			return ResultRetrieveBlocks{
 				BaseResult: BaseResult{
@@ -250,13 +292,65 @@ func (dac *DAClient) RetrieveBlocks(ctx context.Context, dataLayerHeight uint64)
 				},
 			}
 		}
+		blocks = append(blocks, block)
+	}
+
+	var timestamp time.Time
+	if tda, ok := dac.DA.(TimestampDA); ok {
+		var err error
+		timestamp, err = tda.GetTimestamp(ctx, dataLayerHeight)
+		if err != nil {
+			return ResultRetrieveBlocks{
+				BaseResult: BaseResult{
+					Code:     StatusError,
+					Message:  fmt.Sprintf("failed to get timestamp: %s", err.Error()),
+					DAHeight: dataLayerHeight,
+				},
+			}
+		}
 	}
 
 	return ResultRetrieveBlocks{
-		BaseResult: BaseResult{
-			Code:     StatusSuccess,
+		BaseResult: res,
+		Blocks:     blocks,
+		Timestamp:  timestamp,
+		Proofs:     proofs,
+	}
+}
+
+// retrieve returns all blobs posted in DALC namespace at given DA height.
+func (dac *DAClient) retrieve(ctx context.Context, dataLayerHeight uint64) ([][]byte, BaseResult) {
+	ids, err := dac.DA.GetIDs(ctx, dataLayerHeight, dac.Namespace)
+	if err != nil {
+		return nil, BaseResult{
+			Code:     StatusError,
+			Message:  fmt.Sprintf("failed to get IDs: %s", err.Error()),
+			DAHeight: dataLayerHeight,
+		}
+	}
+
+	// If no blobs are found, return a non-blocking error.
+	if len(ids) == 0 {
+		return nil, BaseResult{
+			Code:     StatusNotFound,
+			Message:  ErrBlobNotFound.Error(),
 			DAHeight: dataLayerHeight,
-		},
-		Blocks: blocks,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dac.RetrieveTimeout)
+	defer cancel()
+	blobs, err := dac.DA.Get(ctx, ids, dac.Namespace)
+	if err != nil {
+		return nil, BaseResult{
+			Code:     StatusError,
+			Message:  fmt.Sprintf("failed to get blobs: %s", err.Error()),
+			DAHeight: dataLayerHeight,
+		}
+	}
+
+	return blobs, BaseResult{
+		Code:     StatusSuccess,
+		DAHeight: dataLayerHeight,
 	}
 }
@@ -133,6 +133,7 @@ func TestSubmitRetrieve(t *testing.T) {
 		{"submit_small_blocks_batch", doTestSubmitSmallBlocksBatch},
 		{"submit_large_blocks_overflow", doTestSubmitLargeBlocksOverflow},
 		{"retrieve_no_blocks_found", doTestRetrieveNoBlocksFound},
+		{"submit_retrieve_proofs", doTestSubmitRetrieveProofs},
 	}
 	for name, dalc := range clients {
 		for _, tc := range tests {
@@ -369,3 +370,74 @@ func doTestRetrieveNoBlocksFound(t *testing.T, dalc *DAClient) {
 	assert.Equal(StatusNotFound, result.Code)
 	assert.Contains(result.Message, ErrBlobNotFound.Error())
 }
+
+func doTestSubmitRetrieveProofs(t *testing.T, dalc *DAClient) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require := require.New(t)
+	assert := assert.New(t)
+
+	maxBlobSize, err := dalc.DA.MaxBlobSize(ctx)
+	require.NoError(err)
+
+	block := types.GetRandomBlock(1, 10)
+	proof := &types.ValidityProof{Height: 1, BlockHash: block.Hash(), Proof: []byte("proof")}
+
+	blockResp := dalc.SubmitBlocks(ctx, []*types.Block{block}, maxBlobSize, -1)
+	require.Equal(StatusSuccess, blockResp.Code, blockResp.Message)
+	proofResp := dalc.SubmitProofs(ctx, []*types.ValidityProof{proof}, maxBlobSize, -1)
+	require.Equal(StatusSuccess, proofResp.Code, proofResp.Message)
+	assert.EqualValues(1, proofResp.SubmittedCount)
+
+	// blocks and proofs share namespace, proofs are distinguished from blocks by the tag of the blob
+	retrieved := dalc.RetrieveBlocks(ctx, blockResp.DAHeight)
+	require.Equal(StatusSuccess, retrieved.Code, retrieved.Message)
+	require.Len(retrieved.Blocks, 1)
+	assert.Equal(block.Hash(), retrieved.Blocks[0].Hash())
+	assert.Equal(block.Data.Txs, retrieved.Blocks[0].Data.Txs)
+	assert.Empty(retrieved.Proofs)
+
+	retrieved = dalc.RetrieveBlocks(ctx, proofResp.DAHeight)
+	require.Equal(StatusSuccess, retrieved.Code, retrieved.Message)
+	assert.Empty(retrieved.Blocks)
+	require.Len(retrieved.Proofs, 1)
+	assert.Equal(proof, retrieved.Proofs[0])
+
+	emptyResp := dalc.SubmitProofs(ctx, nil, maxBlobSize, -1)
+	assert.Equal(StatusError, emptyResp.Code)
+}
+
+type timestampDA struct {
+	da.DA
+	timestamp time.Time
+}
+
+func (t *timestampDA) GetTimestamp(_ context.Context, _ uint64) (time.Time, error) {
+	return t.timestamp, nil
+}
+
+func TestRetrieveBlocksTimestamp(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	timestamp := time.Now().Truncate(time.Second)
+	dalc := NewDAClient(&timestampDA{DA: goDATest.NewDummyDA(), timestamp: timestamp}, -1, -1, nil, log.TestingLogger())
+	maxBlobSize, err := dalc.DA.MaxBlobSize(ctx)
+	require.NoError(err)
+
+	resp := dalc.SubmitBlocks(ctx, []*types.Block{types.GetRandomBlock(1, 1)}, maxBlobSize, -1)
+	require.Equal(StatusSuccess, resp.Code, resp.Message)
+
+	retrieved := dalc.RetrieveBlocks(ctx, resp.DAHeight)
+	require.Equal(StatusSuccess, retrieved.Code, retrieved.Message)
+	require.Len(retrieved.Blocks, 1)
+	assert.Equal(t, timestamp, retrieved.Timestamp)
+
+	dummyClient := NewDAClient(goDATest.NewDummyDA(), -1, -1, nil, log.TestingLogger())
+	resp = dummyClient.SubmitBlocks(ctx, []*types.Block{types.GetRandomBlock(1, 1)}, maxBlobSize, -1)
+	require.Equal(StatusSuccess, resp.Code, resp.Message)
+	retrieved = dummyClient.RetrieveBlocks(ctx, resp.DAHeight)
+	require.Equal(StatusSuccess, retrieved.Code, retrieved.Message)
+	assert.True(t, retrieved.Timestamp.IsZero())
+}
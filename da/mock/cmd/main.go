@@ -11,7 +11,7 @@ import (
 	"syscall"
 
 	proxy "github.com/rollkit/go-da/proxy/jsonrpc"
-	goDATest "github.com/rollkit/go-da/test"
+	damock "github.com/rollkit/rollkit/da/mock"
 )
 
 const (
@@ -29,7 +29,7 @@ func main() {
 	flag.StringVar(&host, "host", addr.Hostname(), "listening address")
 	flag.Parse()
 
-	srv := proxy.NewServer(host, port, goDATest.NewDummyDA())
+	srv := proxy.NewServer(host, port, damock.NewDummyDA())
 	log.Printf("Listening on: %s:%s", host, port)
 	if err := srv.Start(context.Background()); err != nil {
 		log.Fatal("error while serving:", err)
@@ -0,0 +1,54 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rollkit/go-da"
+	goDATest "github.com/rollkit/go-da/test"
+)
+
+// DummyDA is an in-memory DA (see DummyDA of go-da), recording the time of every DA block, so it implements
+// TimestampDA of rollkit DA client. Intended only for testing!
+type DummyDA struct {
+	*goDATest.DummyDA
+
+	mtx sync.Mutex
+	// height mirrors the height of DummyDA, incremented on every submission
+	height uint64
+	times  map[uint64]time.Time
+}
+
+// NewDummyDA creates new instance of DummyDA.
+func NewDummyDA() *DummyDA {
+	return &DummyDA{
+		DummyDA: goDATest.NewDummyDA(),
+		times:   make(map[uint64]time.Time),
+	}
+}
+
+// Submit stores blobs in a new DA block, and records the time of the block.
+func (d *DummyDA) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace) ([]da.ID, error) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	ids, err := d.DummyDA.Submit(ctx, blobs, gasPrice, ns)
+	if err != nil {
+		return nil, err
+	}
+	d.height++
+	d.times[d.height] = time.Now()
+	return ids, nil
+}
+
+// GetTimestamp returns the time of DA block at given height.
+func (d *DummyDA) GetTimestamp(_ context.Context, height uint64) (time.Time, error) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	timestamp, ok := d.times[height]
+	if !ok {
+		return time.Time{}, fmt.Errorf("no DA block at height %d", height)
+	}
+	return timestamp, nil
+}
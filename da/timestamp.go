@@ -0,0 +1,84 @@
+package da
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/filecoin-project/go-jsonrpc"
+
+	goDA "github.com/rollkit/go-da"
+	proxyda "github.com/rollkit/go-da/proxy"
+)
+
+// jsonrpcMethodNotFound is the JSON-RPC error code returned for methods not served by the server.
+const jsonrpcMethodNotFound = -32601
+
+// errMethodNotFound is returned by JSON-RPC client if DA server doesn't serve the method.
+type errMethodNotFound struct{}
+
+func (e *errMethodNotFound) Error() string {
+	return "method not found"
+}
+
+// timestampClient is a JSON-RPC client of DA layer implementing TimestampDA, with the time of DA blocks returned by
+// `da.GetTimestamp` method of DA server.
+type timestampClient struct {
+	goDA.DA
+
+	internal struct {
+		GetTimestamp func(ctx context.Context, height uint64) (time.Time, error)
+	}
+	// unsupported is set if DA server doesn't serve GetTimestamp method
+	unsupported atomic.Bool
+}
+
+var _ TimestampDA = &timestampClient{}
+
+// NewClient returns a client of DA layer at given URI, with given auth token (see proxy.NewClient of go-da).
+//
+// JSON-RPC clients (http and https schemes) implement TimestampDA, with the time of DA blocks returned by DA server,
+// if it serves `da.GetTimestamp` method (DA implementations served with go-da proxy serve all methods of the DA,
+// including GetTimestamp, if it's implemented). Otherwise, the time of DA blocks is unknown.
+func NewClient(uri, token string) (goDA.DA, error) {
+	client, err := proxyda.NewClient(uri, token)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	if addr.Scheme != "http" && addr.Scheme != "https" {
+		return client, nil
+	}
+
+	tc := &timestampClient{DA: client}
+	errs := jsonrpc.NewErrors()
+	errs.Register(jsonrpcMethodNotFound, new(*errMethodNotFound))
+	authHeader := http.Header{"Authorization": []string{fmt.Sprintf("Bearer %s", token)}}
+	_, err = jsonrpc.NewMergeClient(context.Background(), uri, "da", []interface{}{&tc.internal}, authHeader,
+		jsonrpc.WithErrors(errs))
+	if err != nil {
+		return nil, err
+	}
+	return tc, nil
+}
+
+// GetTimestamp returns the time of DA block at given height, or zero time if DA server doesn't serve it.
+func (c *timestampClient) GetTimestamp(ctx context.Context, height uint64) (time.Time, error) {
+	if c.unsupported.Load() {
+		return time.Time{}, nil
+	}
+	timestamp, err := c.internal.GetTimestamp(ctx, height)
+	var notFound *errMethodNotFound
+	if errors.As(err, &notFound) {
+		c.unsupported.Store(true)
+		return time.Time{}, nil
+	}
+	return timestamp, err
+}
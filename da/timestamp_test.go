@@ -0,0 +1,69 @@
+package da
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	proxyjsonrpc "github.com/rollkit/go-da/proxy/jsonrpc"
+
+	"github.com/rollkit/rollkit/da/mock"
+	"github.com/rollkit/rollkit/types"
+)
+
+// mockDAAddressTimestamp is the address of JSONRPC server of DA recording the time of DA blocks
+const mockDAAddressTimestamp = "http://localhost:7989"
+
+func TestClientTimestamp(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	addr, _ := url.Parse(mockDAAddressTimestamp)
+	srv := proxyjsonrpc.NewServer(addr.Hostname(), addr.Port(), mock.NewDummyDA())
+	require.NoError(srv.Start(ctx))
+	defer srv.Stop(context.Background()) //nolint:errcheck
+
+	client, err := NewClient(mockDAAddressTimestamp, "")
+	require.NoError(err)
+	require.Implements((*TimestampDA)(nil), client)
+	dalc := NewDAClient(client, -1, -1, nil, log.TestingLogger())
+	maxBlobSize, err := client.MaxBlobSize(ctx)
+	require.NoError(err)
+
+	before := time.Now()
+	resp := dalc.SubmitBlocks(ctx, []*types.Block{types.GetRandomBlock(1, 1)}, maxBlobSize, -1)
+	require.Equal(StatusSuccess, resp.Code, resp.Message)
+	retrieved := dalc.RetrieveBlocks(ctx, resp.DAHeight)
+	require.Equal(StatusSuccess, retrieved.Code, retrieved.Message)
+	require.Len(retrieved.Blocks, 1)
+	assert.False(t, retrieved.Timestamp.Before(before.Truncate(time.Second)))
+	assert.False(t, retrieved.Timestamp.After(time.Now()))
+
+	// unknown DA block
+	retrieved = dalc.RetrieveBlocks(ctx, resp.DAHeight+10)
+	assert.NotEqual(t, StatusSuccess, retrieved.Code)
+}
+
+func TestClientTimestampUnsupported(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// DA served by MockDAAddressHTTP doesn't implement GetTimestamp
+	client, err := NewClient(MockDAAddressHTTP, "")
+	require.NoError(err)
+	timestamp, err := client.(TimestampDA).GetTimestamp(ctx, 1)
+	require.NoError(err)
+	assert.True(t, timestamp.IsZero())
+
+	client, err = NewClient(MockDAAddress, "")
+	require.NoError(err)
+	_, ok := client.(TimestampDA)
+	assert.False(t, ok)
+}
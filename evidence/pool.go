@@ -0,0 +1,288 @@
+package evidence
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtypes "github.com/cometbft/cometbft/types"
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+
+	"github.com/rollkit/rollkit/third_party/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+// Keys of the pool are in a dedicated namespace, as the pool shares the key space with other components of the node
+// (e.g. the main store uses "p" and "c" namespaces for validity proofs and commits).
+const (
+	namespace       = "evidence"
+	pendingPrefix   = namespace + "/pending"
+	committedPrefix = namespace + "/committed"
+)
+
+var (
+	// ErrEvidenceCommitted is returned when evidence was already included in a block.
+	ErrEvidenceCommitted = errors.New("evidence already committed")
+
+	// ErrEvidenceNotFound is returned when evidence is neither pending nor committed.
+	ErrEvidenceNotFound = errors.New("evidence not found")
+
+	// ErrDuplicateEvidence is returned when the same evidence is included in a block more than once.
+	ErrDuplicateEvidence = errors.New("duplicate evidence")
+)
+
+// Info describes evidence known to the pool.
+type Info struct {
+	Evidence cmtypes.Evidence `json:"evidence"`
+	// Height of the block including the evidence; 0 for pending evidence.
+	Height uint64 `json:"height"`
+	// Pending is true if evidence was not yet included in a block.
+	Pending bool `json:"pending"`
+}
+
+// Pool keeps evidence of misbehavior until it's included in a block, and persists committed evidence.
+//
+// Evidence is added to the pool via RPC, from P2P network, or by internal detectors (e.g. of sequencer publishing
+// conflicting blocks). Evidence is expected to be verified before it's added.
+//
+// Evidence expires once it's older than both MaxAgeNumBlocks and MaxAgeDuration of evidence params, relative to the
+// last committed block (see Update). Expired evidence is not accepted, and expired pending evidence is removed.
+type Pool struct {
+	mtx     sync.Mutex
+	store   ds.TxnDatastore
+	pending []cmtypes.Evidence
+	logger  log.Logger
+
+	// height, time and evidence params of the last committed block, used to check if evidence expired
+	height    uint64
+	blockTime time.Time
+	params    *cmproto.EvidenceParams
+}
+
+// NewPool creates new evidence pool, loading pending evidence from the store.
+func NewPool(ctx context.Context, store ds.TxnDatastore, logger log.Logger) (*Pool, error) {
+	pool := &Pool{
+		store:  store,
+		logger: logger,
+	}
+	results, err := store.Query(ctx, dsq.Query{Prefix: "/" + pendingPrefix})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		ev, err := unmarshalEvidence(result.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load pending evidence: %w", err)
+		}
+		pool.pending = append(pool.pending, ev)
+	}
+	return pool, nil
+}
+
+// AddEvidence adds evidence to the pool. Adding already pending evidence is a no-op.
+func (p *Pool) AddEvidence(ctx context.Context, ev cmtypes.Evidence) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	hash := ev.Hash()
+	if p.isExpired(ev) {
+		return fmt.Errorf("%w: %X", types.ErrEvidenceExpired, hash)
+	}
+	if _, err := p.store.Get(ctx, committedKey(hash)); err == nil {
+		return fmt.Errorf("%w: %X", ErrEvidenceCommitted, hash)
+	} else if !errors.Is(err, ds.ErrNotFound) {
+		return err
+	}
+	if has, err := p.store.Has(ctx, pendingKey(hash)); err != nil || has {
+		return err
+	}
+	bz, err := marshalEvidence(ev)
+	if err != nil {
+		return err
+	}
+	if err := p.store.Put(ctx, pendingKey(hash), bz); err != nil {
+		return err
+	}
+	p.pending = append(p.pending, ev)
+	p.logger.Info("added evidence", "hash", fmt.Sprintf("%X", hash), "height", ev.Height())
+	return nil
+}
+
+// PendingEvidence returns pending evidence that didn't expire, in the order it was added, up to maxBytes, and its size.
+func (p *Pool) PendingEvidence(maxBytes int64) ([]cmtypes.Evidence, int64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	var evidence []cmtypes.Evidence
+	var size int64
+	for _, ev := range p.pending {
+		if p.isExpired(ev) {
+			continue
+		}
+		pev, err := cmtypes.EvidenceToProto(ev)
+		if err != nil {
+			continue
+		}
+		evSize := int64(pev.Size())
+		if size+evSize > maxBytes {
+			break
+		}
+		size += evSize
+		evidence = append(evidence, ev)
+	}
+	return evidence, size
+}
+
+// CheckEvidence returns an error if evidence was already committed, or is duplicated.
+func (p *Pool) CheckEvidence(evidence []cmtypes.Evidence) error {
+	seen := make(map[string]struct{}, len(evidence))
+	for _, ev := range evidence {
+		hash := ev.Hash()
+		if _, ok := seen[string(hash)]; ok {
+			return fmt.Errorf("%w: %X", ErrDuplicateEvidence, hash)
+		}
+		seen[string(hash)] = struct{}{}
+		has, err := p.store.Has(context.Background(), committedKey(hash))
+		if err != nil {
+			return err
+		}
+		if has {
+			return fmt.Errorf("%w: %X", ErrEvidenceCommitted, hash)
+		}
+	}
+	return nil
+}
+
+// Update marks evidence included in the last block of the state as committed, and removes pending evidence that
+// expired at that block.
+func (p *Pool) Update(state types.State, evidence []cmtypes.Evidence) error {
+	ctx := context.Background()
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.height = state.LastBlockHeight
+	p.blockTime = state.LastBlockTime
+	p.params = nil
+	if params := state.ConsensusParams.GetEvidence(); params != nil {
+		p.params = &cmproto.EvidenceParams{MaxAgeNumBlocks: params.MaxAgeNumBlocks, MaxAgeDuration: params.MaxAgeDuration}
+	}
+
+	removed := make(map[string]struct{}, len(evidence))
+	for _, ev := range evidence {
+		removed[string(ev.Hash())] = struct{}{}
+	}
+	var expired []cmtypes.Evidence
+	for _, ev := range p.pending {
+		if _, ok := removed[string(ev.Hash())]; !ok && p.isExpired(ev) {
+			expired = append(expired, ev)
+			removed[string(ev.Hash())] = struct{}{}
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+
+	batch, err := p.store.NewTransaction(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer batch.Discard(ctx)
+	for _, ev := range evidence {
+		hash := ev.Hash()
+		bz, err := marshalEvidence(ev)
+		if err != nil {
+			return err
+		}
+		value := binary.BigEndian.AppendUint64(nil, state.LastBlockHeight)
+		if err := batch.Put(ctx, committedKey(hash), append(value, bz...)); err != nil {
+			return err
+		}
+		if err := batch.Delete(ctx, pendingKey(hash)); err != nil {
+			return err
+		}
+	}
+	for _, ev := range expired {
+		if err := batch.Delete(ctx, pendingKey(ev.Hash())); err != nil {
+			return err
+		}
+	}
+	if err := batch.Commit(ctx); err != nil {
+		return err
+	}
+	for _, ev := range expired {
+		p.logger.Info("removed expired evidence", "hash", fmt.Sprintf("%X", ev.Hash()), "height", ev.Height())
+	}
+	pending := p.pending[:0]
+	for _, ev := range p.pending {
+		if _, ok := removed[string(ev.Hash())]; !ok {
+			pending = append(pending, ev)
+		}
+	}
+	p.pending = pending
+	return nil
+}
+
+// isExpired returns true if evidence expired at the last committed block.
+func (p *Pool) isExpired(ev cmtypes.Evidence) bool {
+	return types.IsEvidenceExpired(ev, p.height, p.blockTime, p.params)
+}
+
+// GetEvidence returns pending or committed evidence with given hash.
+func (p *Pool) GetEvidence(ctx context.Context, hash []byte) (*Info, error) {
+	bz, err := p.store.Get(ctx, committedKey(hash))
+	if err == nil {
+		if len(bz) < 8 {
+			return nil, fmt.Errorf("invalid committed evidence %X", hash)
+		}
+		ev, err := unmarshalEvidence(bz[8:])
+		if err != nil {
+			return nil, err
+		}
+		return &Info{Evidence: ev, Height: binary.BigEndian.Uint64(bz[:8])}, nil
+	}
+	if !errors.Is(err, ds.ErrNotFound) {
+		return nil, err
+	}
+	bz, err = p.store.Get(ctx, pendingKey(hash))
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %X", ErrEvidenceNotFound, hash)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ev, err := unmarshalEvidence(bz)
+	if err != nil {
+		return nil, err
+	}
+	return &Info{Evidence: ev, Pending: true}, nil
+}
+
+func marshalEvidence(ev cmtypes.Evidence) ([]byte, error) {
+	pev, err := cmtypes.EvidenceToProto(ev)
+	if err != nil {
+		return nil, err
+	}
+	return pev.Marshal()
+}
+
+func unmarshalEvidence(bz []byte) (cmtypes.Evidence, error) {
+	pev := new(cmproto.Evidence)
+	if err := pev.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return types.EvidenceFromProto(pev)
+}
+
+func pendingKey(hash []byte) ds.Key {
+	return ds.NewKey(fmt.Sprintf("/%s/%X", pendingPrefix, hash))
+}
+
+func committedKey(hash []byte) ds.Key {
+	return ds.NewKey(fmt.Sprintf("/%s/%X", committedPrefix, hash))
+}
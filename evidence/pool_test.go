@@ -0,0 +1,146 @@
+package evidence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+func getEvidence(t *testing.T, height uint64) cmtypes.Evidence {
+	privKey := ed25519.GenPrivKey()
+	first, err := types.GetRandomSignedHeaderCustom(&types.HeaderConfig{Height: height, DataHash: types.GetRandomBytes(32), PrivKey: privKey})
+	require.NoError(t, err)
+	second, err := types.GetRandomSignedHeaderCustom(&types.HeaderConfig{Height: height, DataHash: types.GetRandomBytes(32), PrivKey: privKey})
+	require.NoError(t, err)
+	ev, err := types.NewDuplicateVoteEvidence(first, second)
+	require.NoError(t, err)
+	return ev
+}
+
+func TestPool(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+
+	pool, err := NewPool(ctx, kv, test.NewLogger(t))
+	require.NoError(err)
+	ev1, ev2 := getEvidence(t, 1), getEvidence(t, 2)
+
+	require.NoError(pool.AddEvidence(ctx, ev1))
+	require.NoError(pool.AddEvidence(ctx, ev1))
+	require.NoError(pool.AddEvidence(ctx, ev2))
+
+	pending, size := pool.PendingEvidence(1 << 20)
+	require.Len(pending, 2)
+	assert.Equal(ev1.Hash(), pending[0].Hash())
+	assert.Equal(ev2.Hash(), pending[1].Hash())
+
+	pending, _ = pool.PendingEvidence(size - 1)
+	assert.Len(pending, 1)
+	pending, _ = pool.PendingEvidence(0)
+	assert.Empty(pending)
+
+	info, err := pool.GetEvidence(ctx, ev1.Hash())
+	require.NoError(err)
+	assert.True(info.Pending)
+	assert.Equal(ev1.Hash(), info.Evidence.Hash())
+
+	assert.NoError(pool.CheckEvidence([]cmtypes.Evidence{ev1, ev2}))
+	assert.ErrorIs(pool.CheckEvidence([]cmtypes.Evidence{ev1, ev1}), ErrDuplicateEvidence)
+
+	require.NoError(pool.Update(types.State{LastBlockHeight: 7}, []cmtypes.Evidence{ev1}))
+	pending, _ = pool.PendingEvidence(1 << 20)
+	require.Len(pending, 1)
+	assert.Equal(ev2.Hash(), pending[0].Hash())
+
+	info, err = pool.GetEvidence(ctx, ev1.Hash())
+	require.NoError(err)
+	assert.False(info.Pending)
+	assert.Equal(uint64(7), info.Height)
+	assert.ErrorIs(pool.CheckEvidence([]cmtypes.Evidence{ev1}), ErrEvidenceCommitted)
+	assert.ErrorIs(pool.AddEvidence(ctx, ev1), ErrEvidenceCommitted)
+
+	_, err = pool.GetEvidence(ctx, []byte{1, 2, 3})
+	assert.ErrorIs(err, ErrEvidenceNotFound)
+
+	// pending evidence is loaded on restart
+	restarted, err := NewPool(ctx, kv, test.NewLogger(t))
+	require.NoError(err)
+	pending, _ = restarted.PendingEvidence(1 << 20)
+	require.Len(pending, 1)
+	assert.Equal(ev2.Hash(), pending[0].Hash())
+}
+
+func TestPoolSharedStore(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+
+	pool, err := NewPool(ctx, kv, test.NewLogger(t))
+	require.NoError(err)
+	ev := getEvidence(t, 1)
+	require.NoError(pool.AddEvidence(ctx, ev))
+	require.NoError(pool.Update(types.State{LastBlockHeight: 1}, nil))
+
+	// main store shares the key space with the pool
+	s := store.New(kv)
+	block, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1})
+	require.NoError(s.SaveBlock(ctx, block, &block.SignedHeader.Commit))
+	require.NoError(s.SaveValidityProof(ctx, &types.ValidityProof{Height: 1, BlockHash: block.Hash(), Proof: []byte{1, 2, 3}}))
+
+	restarted, err := NewPool(ctx, kv, test.NewLogger(t))
+	require.NoError(err)
+	pending, _ := restarted.PendingEvidence(1 << 20)
+	require.Len(pending, 1)
+	require.Equal(ev.Hash(), pending[0].Hash())
+
+	info, err := restarted.GetEvidence(ctx, ev.Hash())
+	require.NoError(err)
+	require.True(info.Pending)
+	_, err = restarted.GetEvidence(ctx, block.Hash())
+	require.ErrorIs(err, ErrEvidenceNotFound)
+}
+
+func TestPoolExpiry(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+
+	pool, err := NewPool(ctx, kv, test.NewLogger(t))
+	require.NoError(err)
+	ev1, ev2 := getEvidence(t, 1), getEvidence(t, 20)
+	require.NoError(pool.AddEvidence(ctx, ev1))
+	require.NoError(pool.AddEvidence(ctx, ev2))
+
+	state := types.State{LastBlockHeight: 25, LastBlockTime: ev1.Time().Add(2 * time.Hour)}
+	state.ConsensusParams.Evidence = &cmproto.EvidenceParams{MaxAgeNumBlocks: 10, MaxAgeDuration: time.Hour}
+	require.NoError(pool.Update(state, nil))
+
+	// only evidence older than both MaxAgeNumBlocks and MaxAgeDuration expires
+	pending, _ := pool.PendingEvidence(1 << 20)
+	require.Len(pending, 1)
+	assert.Equal(ev2.Hash(), pending[0].Hash())
+	_, err = pool.GetEvidence(ctx, ev1.Hash())
+	assert.ErrorIs(err, ErrEvidenceNotFound)
+	assert.ErrorIs(pool.AddEvidence(ctx, ev1), types.ErrEvidenceExpired)
+
+	restarted, err := NewPool(ctx, kv, test.NewLogger(t))
+	require.NoError(err)
+	pending, _ = restarted.PendingEvidence(1 << 20)
+	assert.Len(pending, 1)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"sync/atomic"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/cometbft/cometbft/types"
 
 	"github.com/rollkit/rollkit/mempool/clist"
+	rolltypes "github.com/rollkit/rollkit/types"
 )
 
 // CListMempool is an ordered in-memory pool for transactions before they are
@@ -57,8 +59,14 @@ type CListMempool struct {
 	// This reduces the pressure on the proxyApp.
 	cache TxCache
 
-	logger  log.Logger
-	metrics *Metrics
+	logger   log.Logger
+	metrics  *Metrics
+	txStatus *TxStatusTracker
+
+	// encryptedTxs is set in encrypted transactions mode
+	encryptedTxs bool
+
+	admissionCheck AdmissionCheckFunc
 }
 
 var _ Mempool = &CListMempool{}
@@ -129,6 +137,19 @@ func WithMetrics(metrics *Metrics) CListMempoolOption {
 	return func(mem *CListMempool) { mem.metrics = metrics }
 }
 
+// WithTxStatusTracker sets the tracker notified about status changes of transactions.
+func WithTxStatusTracker(tracker *TxStatusTracker) CListMempoolOption {
+	return func(mem *CListMempool) { mem.txStatus = tracker }
+}
+
+// SetAdmissionCheck sets a check rejecting all new transactions while it
+// returns an error. The error is returned by CheckTx as is.
+//
+// NOTE: not thread safe - should only be called once, on startup
+func (mem *CListMempool) SetAdmissionCheck(f AdmissionCheckFunc) {
+	mem.admissionCheck = f
+}
+
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) Lock() {
 	mem.updateMtx.Lock()
@@ -210,27 +231,45 @@ func (mem *CListMempool) CheckTx(
 
 	txSize := len(tx)
 
+	if mem.admissionCheck != nil {
+		if err := mem.admissionCheck(); err != nil {
+			mem.txStatus.Rejected(tx, err.Error())
+			return err
+		}
+	}
+
 	if err := mem.isFull(txSize); err != nil {
+		mem.txStatus.Rejected(tx, err.Error())
 		return err
 	}
 
 	if txSize > mem.config.MaxTxBytes {
-		return ErrTxTooLarge{
+		err := ErrTxTooLarge{
 			Max:    mem.config.MaxTxBytes,
 			Actual: txSize,
 		}
+		mem.txStatus.Rejected(tx, err.Error())
+		return err
 	}
 
 	if mem.preCheck != nil {
 		if err := mem.preCheck(tx); err != nil {
-			return ErrPreCheck{
+			err := ErrPreCheck{
 				Reason: err,
 			}
+			mem.txStatus.Rejected(tx, err.Error())
+			return err
 		}
 	}
 
+	if mem.encryptedTxs && rolltypes.IsDecryptionKeysTx(tx) {
+		mem.txStatus.Rejected(tx, ErrDecryptionKeysTx.Error())
+		return ErrDecryptionKeysTx
+	}
+
 	// NOTE: proxyAppConn may error if tx buffer is full
 	if err := mem.proxyAppConn.Error(); err != nil {
+		mem.txStatus.Rejected(tx, err.Error())
 		return err
 	}
 
@@ -248,9 +287,23 @@ func (mem *CListMempool) CheckTx(
 		}
 		return ErrTxInCache
 	}
+	mem.txStatus.Received(tx)
+
+	if mem.isEncryptedTx(tx) {
+		// encrypted transaction can't be checked by the application
+		res := checkEncryptedTx(tx)
+		mem.resCbFirstTime(tx, txInfo.SenderID, txInfo.SenderP2PID, res)
+		mem.metrics.Size.Set(float64(mem.Size()))
+		mem.metrics.SizeBytes.Set(float64(mem.SizeBytes()))
+		if cb != nil {
+			cb(res.GetCheckTx())
+		}
+		return nil
+	}
 
 	reqRes, err := mem.proxyAppConn.CheckTxAsync(context.TODO(), &abci.RequestCheckTx{Tx: tx})
 	if err != nil {
+		mem.txStatus.Rejected(tx, err.Error())
 		return err
 	}
 	reqRes.SetCallback(mem.reqResCb(tx, txInfo.SenderID, txInfo.SenderP2PID, cb))
@@ -387,6 +440,7 @@ func (mem *CListMempool) resCbFirstTime(
 				// remove from cache (mempool might have a space later)
 				mem.cache.Remove(tx)
 				mem.logger.Error(err.Error())
+				mem.txStatus.Rejected(tx, err.Error())
 				return
 			}
 
@@ -397,6 +451,7 @@ func (mem *CListMempool) resCbFirstTime(
 			}
 			memTx.senders.Store(peerID, true)
 			mem.addTx(memTx)
+			mem.txStatus.InMempool(tx)
 			mem.logger.Debug(
 				"added good transaction",
 				"tx", types.Tx(tx).Hash(),
@@ -415,6 +470,7 @@ func (mem *CListMempool) resCbFirstTime(
 				"err", postCheckErr,
 			)
 			mem.metrics.FailedTxs.Add(1)
+			mem.txStatus.Rejected(tx, checkTxFailureReason(r.CheckTx, postCheckErr))
 
 			if !mem.config.KeepInvalidTxsInCache {
 				// remove from cache (it might be good later)
@@ -427,6 +483,14 @@ func (mem *CListMempool) resCbFirstTime(
 	}
 }
 
+// checkTxFailureReason describes why transaction failed CheckTx or post check.
+func checkTxFailureReason(res *abci.ResponseCheckTx, postCheckErr error) string {
+	if postCheckErr != nil {
+		return postCheckErr.Error()
+	}
+	return fmt.Sprintf("CheckTx failed with code %d: %s", res.Code, res.Log)
+}
+
 // callback, which is called after the app rechecked the tx.
 //
 // The case where the app checks the tx for the first time is handled by the
@@ -447,11 +511,14 @@ func (mem *CListMempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 				break
 			}
 
-			mem.logger.Error(
-				"re-CheckTx transaction mismatch",
-				"got", types.Tx(tx),
-				"expected", memTx.tx,
-			)
+			// encrypted transactions are not rechecked
+			if !mem.isEncryptedTx(memTx.tx) {
+				mem.logger.Error(
+					"re-CheckTx transaction mismatch",
+					"got", types.Tx(tx),
+					"expected", memTx.tx,
+				)
+			}
 
 			if mem.recheckCursor == mem.recheckEnd {
 				// we reached the end of the recheckTx list without finding a tx
@@ -474,6 +541,7 @@ func (mem *CListMempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 			// Tx became invalidated due to newly committed block.
 			mem.logger.Debug("tx is no longer valid", "tx", types.Tx(tx).Hash(), "res", r, "err", postCheckErr)
 			mem.removeTx(tx, mem.recheckCursor)
+			mem.txStatus.Evicted(tx, checkTxFailureReason(r.CheckTx, postCheckErr))
 			// We remove the invalid tx from the cache because it might be good later
 			if !mem.config.KeepInvalidTxsInCache {
 				mem.cache.Remove(tx)
@@ -646,13 +714,32 @@ func (mem *CListMempool) recheckTxs() {
 		panic("recheckTxs is called, but the mempool is empty")
 	}
 
-	mem.recheckCursor = mem.txs.Front()
-	mem.recheckEnd = mem.txs.Back()
+	// encrypted transactions can't be rechecked by the application
+	var first, last *clist.CElement
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		if mem.isEncryptedTx(e.Value.(*mempoolTx).tx) {
+			continue
+		}
+		if first == nil {
+			first = e
+		}
+		last = e
+	}
+	if first == nil {
+		mem.notifyTxsAvailable()
+		return
+	}
+
+	mem.recheckCursor = first
+	mem.recheckEnd = last
 
 	// Push txs to proxyAppConn
 	// NOTE: globalCb may be called concurrently.
-	for e := mem.txs.Front(); e != nil; e = e.Next() {
+	for e := first; e != nil; e = e.Next() {
 		memTx := e.Value.(*mempoolTx)
+		if mem.isEncryptedTx(memTx.tx) {
+			continue
+		}
 		_, err := mem.proxyAppConn.CheckTxAsync(context.TODO(), &abci.RequestCheckTx{
 			Tx:   memTx.tx,
 			Type: abci.CheckTxType_Recheck,
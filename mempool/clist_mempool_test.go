@@ -3,6 +3,7 @@ package mempool
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	mrand "math/rand"
 	"os"
@@ -313,6 +314,30 @@ func TestMempoolFilters(t *testing.T) {
 	}
 }
 
+func TestMempoolAdmissionCheck(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	errOverloaded := errors.New("overloaded")
+	overloaded := true
+	mp.SetAdmissionCheck(func() error {
+		if overloaded {
+			return errOverloaded
+		}
+		return nil
+	})
+
+	err := mp.CheckTx(types.Tx("key=value"), nil, TxInfo{})
+	require.ErrorIs(t, err, errOverloaded)
+	require.Zero(t, mp.Size())
+
+	overloaded = false
+	require.NoError(t, mp.CheckTx(types.Tx("key=value"), nil, TxInfo{}))
+	require.Equal(t, 1, mp.Size())
+}
+
 func TestMempoolUpdate(t *testing.T) {
 	app := kvstore.NewInMemoryApplication()
 	cc := proxy.NewLocalClientCreator(app)
@@ -0,0 +1,39 @@
+package mempool
+
+import (
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/types"
+
+	rolltypes "github.com/rollkit/rollkit/types"
+)
+
+// ErrDecryptionKeysTx is returned when client submits transaction reserved for revealing decryption keys.
+var ErrDecryptionKeysTx = errors.New("transactions revealing decryption keys can't be submitted")
+
+// EnableEncryptedTxs enables encrypted transactions mode.
+//
+// Encrypted transactions can't be checked by the application, so they are admitted to the mempool without calling
+// CheckTx. They are executed after the sequencer commits to the ordering and decryption keys are revealed.
+//
+// NOTE: not thread safe - should only be called once, on startup
+func (mem *CListMempool) EnableEncryptedTxs() {
+	mem.encryptedTxs = true
+}
+
+// isEncryptedTx returns true if tx is handled as encrypted transaction.
+func (mem *CListMempool) isEncryptedTx(tx types.Tx) bool {
+	return mem.encryptedTxs && rolltypes.IsEncryptedTx(tx)
+}
+
+// checkEncryptedTx validates encrypted transaction and returns the response used instead of the response from the
+// application.
+func checkEncryptedTx(tx types.Tx) *abci.Response {
+	res := &abci.ResponseCheckTx{Code: abci.CodeTypeOK}
+	var etx rolltypes.EncryptedTx
+	if err := etx.UnmarshalBinary(tx); err != nil {
+		res = &abci.ResponseCheckTx{Code: 1, Log: err.Error()}
+	}
+	return &abci.Response{Value: &abci.Response_CheckTx{CheckTx: res}}
+}
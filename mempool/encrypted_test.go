@@ -0,0 +1,47 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	rolltypes "github.com/rollkit/rollkit/types"
+)
+
+func TestEncryptedTxsRecheck(t *testing.T) {
+	require := require.New(t)
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+	mp.EnableEncryptedTxs()
+
+	// encrypted transaction would be rejected by the application
+	etx := rolltypes.EncryptedTx{KeyID: 1, Ciphertext: []byte("ciphertext")}
+	encrypted, err := etx.MarshalBinary()
+	require.NoError(err)
+	require.NoError(mp.CheckTx(encrypted, nil, TxInfo{}))
+	require.NoError(mp.CheckTx([]byte("key=value"), nil, TxInfo{}))
+	require.NoError(mp.CheckTx([]byte("key2=value"), nil, TxInfo{}))
+	require.Equal(3, mp.Size())
+
+	mp.Lock()
+	err = mp.Update(1, []types.Tx{[]byte("key2=value")}, []*abci.ExecTxResult{{Code: abci.CodeTypeOK}}, nil, nil)
+	mp.Unlock()
+	require.NoError(err)
+	require.NoError(mp.FlushAppConn())
+	require.Nil(mp.recheckCursor)
+	require.Equal(2, mp.Size())
+
+	// only encrypted transactions left
+	mp.Lock()
+	err = mp.Update(2, []types.Tx{[]byte("key=value")}, []*abci.ExecTxResult{{Code: abci.CodeTypeOK}}, nil, nil)
+	mp.Unlock()
+	require.NoError(err)
+	require.Nil(mp.recheckCursor)
+	require.Equal(1, mp.Size())
+}
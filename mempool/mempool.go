@@ -110,6 +110,11 @@ type PreCheckFunc func(types.Tx) error
 // transaction doesn't require more gas than available for the block.
 type PostCheckFunc func(types.Tx, *abci.ResponseCheckTx) error
 
+// AdmissionCheckFunc is an optional check executed before any transaction is
+// admitted to the mempool. It rejects all transactions while error is returned,
+// e.g. when the node is overloaded.
+type AdmissionCheckFunc func() error
+
 // PreCheckMaxBytes checks that the size of the transaction is smaller or equal
 // to the expected maxBytes.
 func PreCheckMaxBytes(maxBytes int64) PreCheckFunc {
@@ -0,0 +1,187 @@
+package mempool
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/types"
+)
+
+// DefaultTxStatusTrackerSize is the default number of transactions tracked by TxStatusTracker.
+const DefaultTxStatusTrackerSize = 100000
+
+// EventTxStatus is published every time status of a tracked transaction changes.
+const EventTxStatus = "TxStatus"
+
+func init() {
+	cmjson.RegisterType(EventDataTxStatus{}, "rollkit/event/TxStatus")
+}
+
+// TxStatusCode describes the stage of transaction lifecycle.
+type TxStatusCode string
+
+const (
+	// TxStatusReceived is used when transaction was received, but CheckTx is not finished yet.
+	TxStatusReceived TxStatusCode = "received"
+	// TxStatusInMempool is used when transaction passed CheckTx and was added to mempool.
+	TxStatusInMempool TxStatusCode = "in_mempool"
+	// TxStatusIncluded is used when transaction was included in a block.
+	TxStatusIncluded TxStatusCode = "included"
+	// TxStatusDAIncluded is used when block containing transaction was included in DA layer.
+	TxStatusDAIncluded TxStatusCode = "da_included"
+	// TxStatusEvicted is used when transaction was removed from mempool without being included in a block.
+	TxStatusEvicted TxStatusCode = "evicted"
+	// TxStatusRejected is used when transaction was not admitted to mempool.
+	TxStatusRejected TxStatusCode = "rejected"
+)
+
+// TxStatus describes the current status of a transaction.
+type TxStatus struct {
+	Hash   cmbytes.HexBytes `json:"hash"`
+	Status TxStatusCode     `json:"status"`
+	// Height of the block including transaction.
+	Height uint64 `json:"height,omitempty"`
+	// DAHeight is the height of DA layer block including block with transaction.
+	DAHeight uint64 `json:"da_height,omitempty"`
+	// Reason of eviction or rejection.
+	Reason    string    `json:"reason,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// EventDataTxStatus is published with EventTxStatus.
+type EventDataTxStatus TxStatus
+
+// TxStatusTracker keeps track of the lifecycle of recently seen transactions.
+//
+// Number of tracked transactions is bounded; status of the least recently updated transaction is dropped first.
+// All methods are safe for concurrent use. Updates of nil tracker are ignored.
+type TxStatusTracker struct {
+	mtx      sync.Mutex
+	size     int
+	statuses map[types.TxKey]*list.Element
+	list     *list.List
+
+	eventBus *types.EventBus
+	logger   log.Logger
+}
+
+// NewTxStatusTracker returns a new tracker, keeping status of up to size transactions.
+//
+// If eventBus is not nil, EventTxStatus is published on every status change.
+func NewTxStatusTracker(size int, eventBus *types.EventBus, logger log.Logger) *TxStatusTracker {
+	return &TxStatusTracker{
+		size:     size,
+		statuses: make(map[types.TxKey]*list.Element, size),
+		list:     list.New(),
+		eventBus: eventBus,
+		logger:   logger,
+	}
+}
+
+// Get returns status of transaction with given hash.
+func (t *TxStatusTracker) Get(hash []byte) (TxStatus, bool) {
+	var key types.TxKey
+	if len(hash) != len(key) {
+		return TxStatus{}, false
+	}
+	copy(key[:], hash)
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	e, ok := t.statuses[key]
+	if !ok {
+		return TxStatus{}, false
+	}
+	return *e.Value.(*TxStatus), true
+}
+
+// Received records that transaction was received by the node.
+func (t *TxStatusTracker) Received(tx types.Tx) {
+	t.update(tx, TxStatus{Status: TxStatusReceived})
+}
+
+// InMempool records that transaction was added to mempool.
+func (t *TxStatusTracker) InMempool(tx types.Tx) {
+	t.update(tx, TxStatus{Status: TxStatusInMempool})
+}
+
+// Rejected records that transaction was not admitted to mempool.
+func (t *TxStatusTracker) Rejected(tx types.Tx, reason string) {
+	t.update(tx, TxStatus{Status: TxStatusRejected, Reason: reason})
+}
+
+// Evicted records that transaction was removed from mempool without being included in a block.
+func (t *TxStatusTracker) Evicted(tx types.Tx, reason string) {
+	t.update(tx, TxStatus{Status: TxStatusEvicted, Reason: reason})
+}
+
+// Included records that transactions were included in a block at given height.
+func (t *TxStatusTracker) Included(txs types.Txs, height uint64) {
+	for _, tx := range txs {
+		t.update(tx, TxStatus{Status: TxStatusIncluded, Height: height})
+	}
+}
+
+// DAIncluded records that block at given height, containing transactions, was included in DA layer at daHeight.
+func (t *TxStatusTracker) DAIncluded(txs types.Txs, height, daHeight uint64) {
+	for _, tx := range txs {
+		t.update(tx, TxStatus{Status: TxStatusDAIncluded, Height: height, DAHeight: daHeight})
+	}
+}
+
+func (t *TxStatusTracker) update(tx types.Tx, status TxStatus) {
+	if t == nil {
+		return
+	}
+	key := tx.Key()
+	status.Hash = key[:]
+	status.UpdatedAt = time.Now()
+
+	t.mtx.Lock()
+	e, ok := t.statuses[key]
+	if ok {
+		if !canTransition(e.Value.(*TxStatus).Status, status.Status) {
+			t.mtx.Unlock()
+			return
+		}
+		e.Value = &status
+		t.list.MoveToBack(e)
+	} else {
+		if t.list.Len() >= t.size {
+			if front := t.list.Front(); front != nil {
+				delete(t.statuses, types.TxKey(front.Value.(*TxStatus).Hash))
+				t.list.Remove(front)
+			}
+		}
+		t.statuses[key] = t.list.PushBack(&status)
+	}
+	t.mtx.Unlock()
+
+	if t.eventBus != nil {
+		if err := t.eventBus.Publish(EventTxStatus, EventDataTxStatus(status)); err != nil {
+			t.logger.Error("failed to publish tx status event", "error", err)
+		}
+	}
+}
+
+// canTransition returns true if transaction can move from one status to another.
+//
+// Callbacks are asynchronous, so stale updates (e.g. rejection of a duplicate of already included transaction) are
+// ignored. Rejected and evicted transactions can be re-submitted.
+func canTransition(from, to TxStatusCode) bool {
+	switch to {
+	case TxStatusReceived, TxStatusInMempool, TxStatusRejected:
+		return from == TxStatusReceived || from == TxStatusRejected || from == TxStatusEvicted
+	case TxStatusEvicted:
+		return from == TxStatusInMempool
+	case TxStatusIncluded:
+		return from != TxStatusDAIncluded
+	case TxStatusDAIncluded:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package mempool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxStatusTracker(t *testing.T) {
+	require := require.New(t)
+	tracker := NewTxStatusTracker(2, nil, log.NewNopLogger())
+
+	tx := types.Tx("tx")
+	_, ok := tracker.Get(tx.Hash())
+	require.False(ok)
+
+	tracker.Received(tx)
+	tracker.InMempool(tx)
+	status, ok := tracker.Get(tx.Hash())
+	require.True(ok)
+	assert.Equal(t, TxStatusInMempool, status.Status)
+
+	// duplicate can't be rejected after it was admitted to mempool
+	tracker.Rejected(tx, "mempool is full")
+	status, _ = tracker.Get(tx.Hash())
+	assert.Equal(t, TxStatusInMempool, status.Status)
+
+	tracker.Included(types.Txs{tx}, 5)
+	tracker.DAIncluded(types.Txs{tx}, 5, 10)
+	tracker.Included(types.Txs{tx}, 5)
+	status, _ = tracker.Get(tx.Hash())
+	assert.Equal(t, TxStatusDAIncluded, status.Status)
+	assert.Equal(t, uint64(5), status.Height)
+	assert.Equal(t, uint64(10), status.DAHeight)
+
+	// evicted transactions can be re-submitted
+	evicted := types.Tx("evicted")
+	tracker.InMempool(evicted)
+	tracker.Evicted(evicted, "no longer valid")
+	status, _ = tracker.Get(evicted.Hash())
+	assert.Equal(t, TxStatusEvicted, status.Status)
+	assert.Equal(t, "no longer valid", status.Reason)
+	tracker.Received(evicted)
+	status, _ = tracker.Get(evicted.Hash())
+	assert.Equal(t, TxStatusReceived, status.Status)
+
+	// least recently updated transaction is dropped
+	tracker.Received(types.Tx("other"))
+	_, ok = tracker.Get(tx.Hash())
+	require.False(ok)
+	_, ok = tracker.Get(evicted.Hash())
+	require.True(ok)
+}
+
+func TestTxStatusTrackerEvents(t *testing.T) {
+	require := require.New(t)
+	eventBus := types.NewEventBus()
+	require.NoError(eventBus.Start())
+	defer func() { _ = eventBus.Stop() }()
+	sub, err := eventBus.Subscribe(context.Background(), "test", types.QueryForEvent(EventTxStatus))
+	require.NoError(err)
+
+	tracker := NewTxStatusTracker(DefaultTxStatusTrackerSize, eventBus, log.NewNopLogger())
+	tx := types.Tx("tx")
+	tracker.Included(types.Txs{tx}, 1)
+
+	select {
+	case msg := <-sub.Out():
+		data, ok := msg.Data().(EventDataTxStatus)
+		require.True(ok)
+		assert.Equal(t, TxStatusIncluded, data.Status)
+		assert.Equal(t, tx.Hash(), []byte(data.Hash))
+	case <-time.After(time.Second):
+		t.Fatal("tx status event not published")
+	}
+}
+
+func TestMempoolTxStatus(t *testing.T) {
+	require := require.New(t)
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+	mp.txStatus = NewTxStatusTracker(DefaultTxStatusTrackerSize, nil, log.NewNopLogger())
+
+	good := types.Tx("key=value")
+	require.NoError(mp.CheckTx(good, nil, TxInfo{}))
+	status, ok := mp.txStatus.Get(good.Hash())
+	require.True(ok)
+	assert.Equal(t, TxStatusInMempool, status.Status)
+
+	bad := types.Tx("invalid")
+	require.NoError(mp.CheckTx(bad, nil, TxInfo{}))
+	status, ok = mp.txStatus.Get(bad.Hash())
+	require.True(ok)
+	assert.Equal(t, TxStatusRejected, status.Status)
+	assert.NotEmpty(t, status.Reason)
+
+	tooLarge := types.Tx(make([]byte, mp.config.MaxTxBytes+1))
+	require.Error(mp.CheckTx(tooLarge, nil, TxInfo{}))
+	status, ok = mp.txStatus.Get(tooLarge.Hash())
+	require.True(ok)
+	assert.Equal(t, TxStatusRejected, status.Status)
+}
@@ -0,0 +1,65 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/rollkit/rollkit/evidence"
+	"github.com/rollkit/rollkit/p2p"
+	"github.com/rollkit/rollkit/types"
+)
+
+// gossipingEvidencePool gossips evidence added by the node (submitted via RPC or detected by the block manager) to the
+// P2P network, so it reaches the sequencer, to be included in a block, and other full nodes.
+type gossipingEvidencePool struct {
+	*evidence.Pool
+	p2pClient *p2p.Client
+}
+
+// AddEvidence adds evidence to the pool and gossips it.
+func (p *gossipingEvidencePool) AddEvidence(ctx context.Context, ev cmtypes.Evidence) error {
+	if err := p.Pool.AddEvidence(ctx, ev); err != nil {
+		return err
+	}
+	pev, err := cmtypes.EvidenceToProto(ev)
+	if err != nil {
+		return err
+	}
+	bz, err := pev.Marshal()
+	if err != nil {
+		return err
+	}
+	return p.p2pClient.GossipEvidence(ctx, bz)
+}
+
+// newEvidenceValidator creates a pubsub validator that verifies gossiped evidence against the sequencer set, and adds it
+// to the evidence pool. Evidence that was already committed or expired is not relayed.
+func (n *FullNode) newEvidenceValidator(metrics *p2p.Metrics) p2p.GossipValidator {
+	return func(m *p2p.GossipMessage) bool {
+		n.Logger.Debug("evidence received", "bytes", len(m.Data))
+		metrics.PeerReceiveBytesTotal.With("peer_id", m.From.String(), "chID", n.genesis.ChainID).Add(float64(len(m.Data)))
+		metrics.MessageReceiveBytesTotal.With("message_type", "evidence").Add(float64(len(m.Data)))
+		pev := new(cmproto.Evidence)
+		if err := pev.Unmarshal(m.Data); err != nil {
+			n.Logger.Debug("failed to unmarshal evidence", "error", err)
+			return false
+		}
+		ev, err := types.EvidenceFromProto(pev)
+		if err != nil {
+			n.Logger.Debug("failed to decode evidence", "error", err)
+			return false
+		}
+		if err := n.blockManager.VerifyEvidence(ev); err != nil {
+			n.Logger.Debug("rejecting invalid evidence", "hash", fmt.Sprintf("%X", ev.Hash()), "error", err)
+			return false
+		}
+		if err := n.evpool.AddEvidence(n.ctx, ev); err != nil {
+			n.Logger.Debug("rejecting evidence", "hash", fmt.Sprintf("%X", ev.Hash()), "error", err)
+			return false
+		}
+		return true
+	}
+}
@@ -8,13 +8,17 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	ds "github.com/ipfs/go-datastore"
 	ktds "github.com/ipfs/go-datastore/keytransform"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
+	abcicli "github.com/cometbft/cometbft/abci/client"
 	abci "github.com/cometbft/cometbft/abci/types"
 	llcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/log"
@@ -24,14 +28,14 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmtypes "github.com/cometbft/cometbft/types"
 
-	proxyda "github.com/rollkit/go-da/proxy"
-
 	"github.com/rollkit/rollkit/block"
 	"github.com/rollkit/rollkit/config"
 	"github.com/rollkit/rollkit/da"
+	"github.com/rollkit/rollkit/evidence"
 	"github.com/rollkit/rollkit/mempool"
 	"github.com/rollkit/rollkit/p2p"
 	"github.com/rollkit/rollkit/state"
+	executorgrpc "github.com/rollkit/rollkit/state/grpc"
 	"github.com/rollkit/rollkit/state/indexer"
 	blockidxkv "github.com/rollkit/rollkit/state/indexer/block/kv"
 	"github.com/rollkit/rollkit/state/txindex"
@@ -42,9 +46,10 @@ import (
 
 // prefixes used in KV store to separate main node data from DALC data
 var (
-	mainPrefix    = "0"
-	dalcPrefix    = "1"
-	indexerPrefix = "2" // indexPrefix uses "i", so using "0-2" to avoid clash
+	mainPrefix     = "0"
+	dalcPrefix     = "1"
+	indexerPrefix  = "2" // indexPrefix uses "i", so using "0-2" to avoid clash
+	evidencePrefix = "3"
 )
 
 const (
@@ -65,8 +70,11 @@ type FullNode struct {
 	genChunks []string
 
 	nodeConfig config.NodeConfig
+	p2pKey     crypto.PrivKey
 
+	// proxyApp, executor, dalc, p2pClient, sync services and blockManager are nil in rpc-only mode
 	proxyApp     proxy.AppConns
+	executor     state.Executor
 	eventBus     *cmtypes.EventBus
 	dalc         *da.DAClient
 	p2pClient    *p2p.Client
@@ -75,6 +83,8 @@ type FullNode struct {
 	// TODO(tzdybal): consider extracting "mempool reactor"
 	Mempool      mempool.Mempool
 	mempoolIDs   *mempoolIDs
+	txStatus     *mempool.TxStatusTracker
+	evpool       *evidence.Pool
 	Store        store.Store
 	blockManager *block.Manager
 	client       rpcclient.Client
@@ -90,6 +100,16 @@ type FullNode struct {
 	ctx           context.Context
 	cancel        context.CancelFunc
 	threadManager *types.ThreadManager
+
+	// standby is set in hot-standby aggregator mode
+	standby bool
+
+	// readOnlyKV is the store of another node, served in rpc-only mode
+	readOnlyKV *store.ReadOnlyKVStore
+
+	// baseKV is the key-value store shared by all components of the node
+	baseKV       ds.TxnDatastore
+	storeMetrics *store.Metrics
 }
 
 // newFullNode creates a new Rollkit full node.
@@ -113,49 +133,32 @@ func newFullNode(
 		}
 	}()
 
-	seqMetrics, p2pMetrics, memplMetrics, smMetrics, abciMetrics := metricsProvider(genesis.ChainID)
-
-	proxyApp, err := initProxyApp(clientCreator, logger, abciMetrics)
-	if err != nil {
-		return nil, err
+	if nodeConfig.RPCOnly && nodeConfig.Aggregator {
+		return nil, errors.New("rpc-only mode can't be used in aggregator mode")
 	}
-
-	eventBus, err := initEventBus(logger)
-	if err != nil {
+	if err := validateStoreMaintenance(nodeConfig); err != nil {
 		return nil, err
 	}
 
-	baseKV, err := initBaseKV(nodeConfig, logger)
-	if err != nil {
-		return nil, err
-	}
+	seqMetrics, p2pMetrics, memplMetrics, smMetrics, abciMetrics, storeMetrics := metricsProvider(genesis.ChainID)
 
-	dalcKV := newPrefixKV(baseKV, dalcPrefix)
-	dalc, err := initDALC(nodeConfig, dalcKV, logger)
+	eventBus, err := initEventBus(logger)
 	if err != nil {
 		return nil, err
 	}
 
-	p2pClient, err := p2p.NewClient(nodeConfig.P2P, p2pKey, genesis.ChainID, baseKV, logger.With("module", "p2p"), p2pMetrics)
+	baseKV, err := initBaseKV(nodeConfig, logger)
 	if err != nil {
 		return nil, err
 	}
-
+	readOnlyKV, _ := baseKV.(*store.ReadOnlyKVStore)
 	mainKV := newPrefixKV(baseKV, mainPrefix)
-	headerSyncService, err := initHeaderSyncService(ctx, mainKV, nodeConfig, genesis, p2pClient, logger)
+	store, err := initStore(ctx, mainKV, nodeConfig, storeMetrics, logger)
 	if err != nil {
 		return nil, err
 	}
 
-	blockSyncService, err := initBlockSyncService(ctx, mainKV, nodeConfig, genesis, p2pClient, logger)
-	if err != nil {
-		return nil, err
-	}
-
-	mempool := initMempool(logger, proxyApp, memplMetrics)
-
-	store := store.New(mainKV)
-	blockManager, err := initBlockManager(signingKey, nodeConfig, genesis, store, mempool, proxyApp, dalc, eventBus, logger, blockSyncService, seqMetrics, smMetrics)
+	evpool, err := evidence.NewPool(ctx, newPrefixKV(baseKV, evidencePrefix), logger.With("module", "evidence"))
 	if err != nil {
 		return nil, err
 	}
@@ -166,34 +169,121 @@ func newFullNode(
 		return nil, err
 	}
 
+	txStatus := mempool.NewTxStatusTracker(mempool.DefaultTxStatusTrackerSize, eventBus, logger.With("module", "txstatus"))
+
 	node := &FullNode{
-		proxyApp:       proxyApp,
 		eventBus:       eventBus,
 		genesis:        genesis,
 		nodeConfig:     nodeConfig,
-		p2pClient:      p2pClient,
-		blockManager:   blockManager,
-		dalc:           dalc,
-		Mempool:        mempool,
+		p2pKey:         p2pKey,
 		mempoolIDs:     newMempoolIDs(),
+		txStatus:       txStatus,
+		evpool:         evpool,
 		Store:          store,
 		TxIndexer:      txIndexer,
 		IndexerService: indexerService,
 		BlockIndexer:   blockIndexer,
-		hSyncService:   headerSyncService,
-		bSyncService:   blockSyncService,
 		ctx:            ctx,
 		cancel:         cancel,
 		threadManager:  types.NewThreadManager(),
+		readOnlyKV:     readOnlyKV,
+		baseKV:         baseKV,
+		storeMetrics:   storeMetrics,
+	}
+
+	if nodeConfig.RPCOnly {
+		// proxy app, P2P client, sync services and block manager are not created, so mempool is only used to reject
+		// transactions
+		node.Mempool = initRPCOnlyMempool(logger, memplMetrics, txStatus)
+		if err := node.loadHeight(ctx); err != nil {
+			return nil, err
+		}
+	} else if err := node.initSyncComponents(ctx, signingKey, mainKV, clientCreator, logger, seqMetrics, p2pMetrics, memplMetrics, smMetrics, abciMetrics); err != nil {
+		return nil, err
 	}
 
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
-	node.p2pClient.SetTxValidator(node.newTxValidator(p2pMetrics))
 	node.client = NewFullClient(node)
 
+	if nodeConfig.Aggregator && nodeConfig.LeaseFile != "" {
+		if err := node.SetLeaseBackend(block.NewFileLeaseBackend(nodeConfig.LeaseFile)); err != nil {
+			return nil, err
+		}
+	}
+
 	return node, nil
 }
 
+// initSyncComponents creates the components syncing the chain or producing blocks: proxy app, DA client, P2P client,
+// sync services, mempool, executor and block manager. They're not used in rpc-only mode.
+func (n *FullNode) initSyncComponents(
+	ctx context.Context,
+	signingKey crypto.PrivKey,
+	mainKV ds.TxnDatastore,
+	clientCreator proxy.ClientCreator,
+	logger log.Logger,
+	seqMetrics *block.Metrics,
+	p2pMetrics *p2p.Metrics,
+	memplMetrics *mempool.Metrics,
+	smMetrics *state.Metrics,
+	abciMetrics *proxy.Metrics,
+) error {
+	nodeConfig, genesis := n.nodeConfig, n.genesis
+	proxyApp, err := initProxyApp(clientCreator, logger, abciMetrics)
+	if err != nil {
+		return err
+	}
+
+	dalcKV := newPrefixKV(n.baseKV, dalcPrefix)
+	dalc, err := initDALC(nodeConfig, dalcKV, logger)
+	if err != nil {
+		return err
+	}
+
+	p2pClient, err := p2p.NewClient(nodeConfig.P2P, n.p2pKey, genesis.ChainID, n.baseKV, logger.With("module", "p2p"), p2pMetrics)
+	if err != nil {
+		return err
+	}
+
+	headerSyncService, err := initHeaderSyncService(ctx, mainKV, nodeConfig, genesis, p2pClient, logger)
+	if err != nil {
+		return err
+	}
+
+	blockSyncService, err := initBlockSyncService(ctx, mainKV, nodeConfig, genesis, p2pClient, logger)
+	if err != nil {
+		return err
+	}
+
+	mempool := initMempool(logger, proxyApp, memplMetrics, n.txStatus)
+
+	executor, err := initExecutor(nodeConfig)
+	if err != nil {
+		return err
+	}
+
+	blockManager, err := initBlockManager(signingKey, nodeConfig, genesis, n.Store, mempool, proxyApp, executor, dalc, n.eventBus, logger, blockSyncService, seqMetrics, smMetrics)
+	if err != nil {
+		return err
+	}
+	blockManager.SetHeaderStore(headerSyncService.HeaderStore())
+	blockManager.SetTxStatusTracker(n.txStatus)
+	blockManager.SetEvidencePool(&gossipingEvidencePool{Pool: n.evpool, p2pClient: p2pClient})
+	mempool.SetAdmissionCheck(blockManager.CheckBackPressure)
+
+	n.proxyApp = proxyApp
+	n.executor = executor
+	n.dalc = dalc
+	n.p2pClient = p2pClient
+	n.hSyncService = headerSyncService
+	n.bSyncService = blockSyncService
+	n.Mempool = mempool
+	n.blockManager = blockManager
+	p2pClient.SetTxValidator(n.newTxValidator(p2pMetrics))
+	p2pClient.SetEvidenceValidator(n.newEvidenceValidator(p2pMetrics))
+	return nil
+}
+
 func initProxyApp(clientCreator proxy.ClientCreator, logger log.Logger, metrics *proxy.Metrics) (proxy.AppConns, error) {
 	proxyApp := proxy.NewAppConns(clientCreator, metrics)
 	proxyApp.SetLogger(logger.With("module", "proxy"))
@@ -212,13 +302,16 @@ func initEventBus(logger log.Logger) (*cmtypes.EventBus, error) {
 	return eventBus, nil
 }
 
-// initBaseKV initializes the base key-value store.
+// initBaseKV initializes the base key-value store. In rpc-only mode, existing store is opened read-only.
 func initBaseKV(nodeConfig config.NodeConfig, logger log.Logger) (ds.TxnDatastore, error) {
+	if nodeConfig.RPCOnly {
+		return store.NewReadOnlyKVStore(nodeConfig.DBBackend, nodeConfig.RootDir, nodeConfig.DBPath, "rollkit")
+	}
 	if nodeConfig.RootDir == "" && nodeConfig.DBPath == "" { // this is used for testing
 		logger.Info("WARNING: working in in-memory mode")
 		return store.NewDefaultInMemoryKVStore()
 	}
-	return store.NewDefaultKVStore(nodeConfig.RootDir, nodeConfig.DBPath, "rollkit")
+	return store.NewKVStore(nodeConfig.DBBackend, nodeConfig.RootDir, nodeConfig.DBPath, "rollkit")
 }
 
 func initDALC(nodeConfig config.NodeConfig, dalcKV ds.TxnDatastore, logger log.Logger) (*da.DAClient, error) {
@@ -232,7 +325,7 @@ func initDALC(nodeConfig config.NodeConfig, dalcKV ds.TxnDatastore, logger log.L
 		return nil, fmt.Errorf("gas multiplier must be greater than or equal to zero")
 	}
 
-	client, err := proxyda.NewClient(nodeConfig.DAAddress, nodeConfig.DAAuthToken)
+	client, err := da.NewClient(nodeConfig.DAAddress, nodeConfig.DAAuthToken)
 	if err != nil {
 		return nil, fmt.Errorf("error while establishing connection to DA layer: %w", err)
 	}
@@ -241,12 +334,24 @@ func initDALC(nodeConfig config.NodeConfig, dalcKV ds.TxnDatastore, logger log.L
 		namespace, logger.With("module", "da_client")), nil
 }
 
-func initMempool(logger log.Logger, proxyApp proxy.AppConns, memplMetrics *mempool.Metrics) *mempool.CListMempool {
-	mempool := mempool.NewCListMempool(llcfg.DefaultMempoolConfig(), proxyApp.Mempool(), 0, mempool.WithMetrics(memplMetrics))
+func initMempool(logger log.Logger, proxyApp proxy.AppConns, memplMetrics *mempool.Metrics, txStatus *mempool.TxStatusTracker) *mempool.CListMempool {
+	mempool := mempool.NewCListMempool(llcfg.DefaultMempoolConfig(), proxyApp.Mempool(), 0, mempool.WithMetrics(memplMetrics), mempool.WithTxStatusTracker(txStatus))
 	mempool.EnableTxsAvailable()
 	return mempool
 }
 
+// initRPCOnlyMempool creates mempool of a node in rpc-only mode, which rejects all transactions with ErrRPCOnly.
+//
+// Transactions are rejected before they're checked by the application, so mempool uses a no-op application instead of
+// the proxy app.
+func initRPCOnlyMempool(logger log.Logger, memplMetrics *mempool.Metrics, txStatus *mempool.TxStatusTracker) *mempool.CListMempool {
+	appConn := proxy.NewAppConnMempool(abcicli.NewLocalClient(nil, abci.NewBaseApplication()), proxy.NopMetrics())
+	mempool := mempool.NewCListMempool(llcfg.DefaultMempoolConfig(), appConn, 0, mempool.WithMetrics(memplMetrics), mempool.WithTxStatusTracker(txStatus))
+	// transactions can't be gossiped without P2P network
+	mempool.SetAdmissionCheck(func() error { return ErrRPCOnly })
+	return mempool
+}
+
 func initHeaderSyncService(ctx context.Context, mainKV ds.TxnDatastore, nodeConfig config.NodeConfig, genesis *cmtypes.GenesisDoc, p2pClient *p2p.Client, logger log.Logger) (*block.HeaderSyncService, error) {
 	headerSyncService, err := block.NewHeaderSyncService(ctx, mainKV, nodeConfig, genesis, p2pClient, logger.With("module", "HeaderSyncService"))
 	if err != nil {
@@ -263,8 +368,20 @@ func initBlockSyncService(ctx context.Context, mainKV ds.TxnDatastore, nodeConfi
 	return blockSyncService, nil
 }
 
-func initBlockManager(signingKey crypto.PrivKey, nodeConfig config.NodeConfig, genesis *cmtypes.GenesisDoc, store store.Store, mempool mempool.Mempool, proxyApp proxy.AppConns, dalc *da.DAClient, eventBus *cmtypes.EventBus, logger log.Logger, blockSyncService *block.BlockSyncService, seqMetrics *block.Metrics, execMetrics *state.Metrics) (*block.Manager, error) {
-	blockManager, err := block.NewManager(signingKey, nodeConfig.BlockManagerConfig, genesis, store, mempool, proxyApp.Consensus(), dalc, eventBus, logger.With("module", "BlockManager"), blockSyncService.BlockStore(), seqMetrics, execMetrics)
+// initExecutor connects to remote executor, if configured. Nil is returned if blocks are executed by ABCI application.
+func initExecutor(nodeConfig config.NodeConfig) (state.Executor, error) {
+	if nodeConfig.ExecutorAddress == "" {
+		return nil, nil
+	}
+	client := executorgrpc.NewClient()
+	if err := client.Start(nodeConfig.ExecutorAddress, grpc.WithTransportCredentials(insecure.NewCredentials())); err != nil {
+		return nil, fmt.Errorf("error while establishing connection to remote executor: %w", err)
+	}
+	return client, nil
+}
+
+func initBlockManager(signingKey crypto.PrivKey, nodeConfig config.NodeConfig, genesis *cmtypes.GenesisDoc, store store.Store, mempool mempool.Mempool, proxyApp proxy.AppConns, executor state.Executor, dalc *da.DAClient, eventBus *cmtypes.EventBus, logger log.Logger, blockSyncService *block.BlockSyncService, seqMetrics *block.Metrics, execMetrics *state.Metrics) (*block.Manager, error) {
+	blockManager, err := block.NewManager(signingKey, nodeConfig.BlockManagerConfig, genesis, store, mempool, proxyApp.Consensus(), executor, dalc, eventBus, logger.With("module", "BlockManager"), blockSyncService.BlockStore(), seqMetrics, execMetrics)
 	if err != nil {
 		return nil, fmt.Errorf("error while initializing BlockManager: %w", err)
 	}
@@ -370,6 +487,11 @@ func (n *FullNode) OnStart() error {
 	if n.nodeConfig.Instrumentation != nil && n.nodeConfig.Instrumentation.IsPrometheusEnabled() {
 		n.prometheusSrv = n.startPrometheusServer()
 	}
+	if n.nodeConfig.RPCOnly {
+		n.Logger.Info("working in rpc-only mode", "refresh interval", n.nodeConfig.BlockTime)
+		n.threadManager.Go(func() { n.refreshLoop(n.ctx) })
+		return nil
+	}
 	n.Logger.Info("starting P2P client")
 	err := n.p2pClient.Start(n.ctx)
 	if err != nil {
@@ -384,20 +506,236 @@ func (n *FullNode) OnStart() error {
 		return fmt.Errorf("error while starting block sync service: %w", err)
 	}
 
+	if n.nodeConfig.DBGCInterval > 0 {
+		n.threadManager.Go(func() { storeMaintenanceLoop(n.ctx, n.baseKV, n.nodeConfig, n.storeMetrics, n.Logger) })
+	}
+
+	if n.nodeConfig.Aggregator && n.standby {
+		n.Logger.Info("working in hot-standby aggregator mode", "block time", n.nodeConfig.BlockTime)
+		n.threadManager.Go(func() { n.standbyLoop(n.ctx) })
+		return nil
+	}
 	if n.nodeConfig.Aggregator {
 		n.Logger.Info("working in aggregator mode", "block time", n.nodeConfig.BlockTime)
-		n.threadManager.Go(func() { n.blockManager.AggregationLoop(n.ctx, n.nodeConfig.LazyAggregator) })
-		n.threadManager.Go(func() { n.blockManager.BlockSubmissionLoop(n.ctx) })
-		n.threadManager.Go(func() { n.headerPublishLoop(n.ctx) })
-		n.threadManager.Go(func() { n.blockPublishLoop(n.ctx) })
+		n.startAggregation()
 		return nil
 	}
 	n.threadManager.Go(func() { n.blockManager.RetrieveLoop(n.ctx) })
 	n.threadManager.Go(func() { n.blockManager.BlockStoreRetrieveLoop(n.ctx) })
 	n.threadManager.Go(func() { n.blockManager.SyncLoop(n.ctx, n.cancel) })
+	n.threadManager.Go(func() { n.blockManager.LivenessLoop(n.ctx) })
 	return nil
 }
 
+// startAggregation starts goroutines producing blocks and publishing them to P2P network and DA layer.
+func (n *FullNode) startAggregation() {
+	n.startBlockProduction(n.ctx, n.threadManager)
+	n.threadManager.Go(func() { n.headerPublishLoop(n.ctx) })
+	n.threadManager.Go(func() { n.blockPublishLoop(n.ctx) })
+}
+
+// startBlockProduction starts goroutines producing blocks and submitting them to DA layer.
+func (n *FullNode) startBlockProduction(ctx context.Context, threads *types.ThreadManager) {
+	threads.Go(func() { n.blockManager.AggregationLoop(ctx, n.nodeConfig.LazyAggregator) })
+	threads.Go(func() { n.blockManager.BlockSubmissionLoop(ctx) })
+	threads.Go(func() { n.blockManager.ProofLoop(ctx) })
+}
+
+// standbyLoop follows the chain until the aggregator lease is acquired, then takes over block production from the
+// last synced height. If the lease is lost, block production is stopped and node goes back to following the chain.
+//
+// Blocks are published to P2P network all the time, so blocks produced before the lease was lost are published too.
+func (n *FullNode) standbyLoop(ctx context.Context) {
+	n.threadManager.Go(func() { n.headerPublishLoop(ctx) })
+	n.threadManager.Go(func() { n.blockPublishLoop(ctx) })
+	for {
+		syncCtx, cancelSync := context.WithCancel(ctx)
+		syncThreads := types.NewThreadManager()
+		syncThreads.Go(func() { n.blockManager.RetrieveLoop(syncCtx) })
+		syncThreads.Go(func() { n.blockManager.BlockStoreRetrieveLoop(syncCtx) })
+		syncThreads.Go(func() { n.blockManager.SyncLoop(syncCtx, n.cancel) })
+
+		err := n.blockManager.WaitForLease(ctx)
+		cancelSync()
+		syncThreads.Wait()
+		if err != nil {
+			return
+		}
+
+		n.Logger.Info("taking over block production", "height", n.Store.Height())
+		aggCtx, cancelAgg := context.WithCancel(ctx)
+		aggThreads := types.NewThreadManager()
+		n.startBlockProduction(aggCtx, aggThreads)
+		err = n.blockManager.LeaseLoop(aggCtx)
+		cancelAgg()
+		aggThreads.Wait()
+		if ctx.Err() != nil {
+			return
+		}
+		n.Logger.Error("block production stopped, returning to hot-standby mode", "height", n.Store.Height(), "error", err)
+	}
+}
+
+// refreshLoop periodically checks if the snapshot of the read-only store was replaced in rpc-only mode, to serve new
+// data written by another node.
+func (n *FullNode) refreshLoop(ctx context.Context) {
+	ticker := time.NewTicker(n.nodeConfig.BlockTime)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := n.refresh(ctx); err != nil {
+			n.Logger.Error("failed to refresh read-only store", "error", err)
+		}
+	}
+}
+
+// refresh reopens the read-only store if the snapshot was replaced, and then updates the height to the last block of
+// the saved state. Nothing is read from the store if the snapshot wasn't replaced.
+func (n *FullNode) refresh(ctx context.Context) error {
+	refreshed, err := n.readOnlyKV.Refresh()
+	if err != nil || !refreshed {
+		return err
+	}
+	if cached, ok := n.Store.(*store.CachedStore); ok {
+		cached.InvalidateState()
+	}
+	if err := n.loadHeight(ctx); err != nil {
+		return err
+	}
+	n.Logger.Info("read-only store refreshed", "height", n.Store.Height())
+	return nil
+}
+
+// loadHeight sets the height of the store to the last block of the saved state. In rpc-only mode blocks are not
+// synced, so the height is set only when the store is opened or refreshed.
+func (n *FullNode) loadHeight(ctx context.Context) error {
+	state, err := n.Store.GetState(ctx)
+	if err != nil {
+		return err
+	}
+	n.Store.SetHeight(ctx, state.LastBlockHeight)
+	return nil
+}
+
+// validateStoreMaintenance checks the configuration of garbage collection of the key-value store.
+func validateStoreMaintenance(nodeConfig config.NodeConfig) error {
+	if nodeConfig.DBGCInterval > 0 && (nodeConfig.DBGCDiscardRatio <= 0 || nodeConfig.DBGCDiscardRatio >= 1) {
+		return fmt.Errorf("database gc discard ratio must be in range (0, 1), got %v", nodeConfig.DBGCDiscardRatio)
+	}
+	return nil
+}
+
+// storeMaintenanceLoop periodically collects garbage of the key-value store and reports its usage, until the context
+// is canceled.
+func storeMaintenanceLoop(ctx context.Context, kv ds.Datastore, nodeConfig config.NodeConfig, metrics *store.Metrics, logger log.Logger) {
+	ticker := time.NewTicker(nodeConfig.DBGCInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		maintainStore(ctx, kv, nodeConfig, metrics, logger)
+	}
+}
+
+// maintainStore collects garbage of the key-value store, updates store usage metrics and logs an alert if disk usage
+// exceeds the configured threshold.
+func maintainStore(ctx context.Context, kv ds.Datastore, nodeConfig config.NodeConfig, metrics *store.Metrics, logger log.Logger) {
+	start := time.Now()
+	if err := store.CollectGarbage(ctx, kv, nodeConfig.DBGCDiscardRatio); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Error("failed to collect database garbage", "error", err)
+	} else {
+		metrics.GCRuns.Add(1)
+		metrics.GCDuration.Set(time.Since(start).Seconds())
+	}
+
+	stats, err := store.GetStats(ctx, kv)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.Error("failed to get database stats", "error", err)
+		}
+		return
+	}
+	metrics.DiskUsage.Set(float64(stats.DiskUsage))
+	for component, c := range stats.Components {
+		metrics.DataSize.With("component", component).Set(float64(c.Size))
+		metrics.DataKeys.With("component", component).Set(float64(c.Keys))
+	}
+	logger.Debug("database maintenance finished", "duration", time.Since(start), "disk usage", stats.DiskUsage)
+	if alert := nodeConfig.DBDiskUsageAlert; alert > 0 && stats.DiskUsage > alert {
+		logger.Error("database disk usage exceeds alert threshold", "disk usage", stats.DiskUsage, "threshold", alert)
+	}
+}
+
+// SetLeaseBackend enables hot-standby aggregator mode. Block production requires holding the lease stored in the
+// backend, shared by all aggregators of the chain. Until the lease is acquired, node follows the chain like a full node.
+//
+// It has to be called before the node is started.
+func (n *FullNode) SetLeaseBackend(backend block.LeaseBackend) error {
+	if n.nodeConfig.RPCOnly {
+		return ErrRPCOnly
+	}
+	id, _, _, err := n.p2pClient.Info()
+	if err != nil {
+		return err
+	}
+	n.blockManager.SetLease(backend, string(id))
+	n.standby = true
+	return nil
+}
+
+// SetProver sets Prover used to generate validity proofs of produced blocks.
+//
+// It has to be called before the node is started. It's a no-op in rpc-only mode.
+func (n *FullNode) SetProver(prover block.Prover) {
+	if n.nodeConfig.RPCOnly {
+		return
+	}
+	n.blockManager.SetProver(prover)
+}
+
+// SetVerifier sets Verifier used to check validity proofs of synced blocks.
+//
+// It has to be called before the node is started. It's a no-op in rpc-only mode.
+func (n *FullNode) SetVerifier(verifier block.Verifier) {
+	if n.nodeConfig.RPCOnly {
+		return
+	}
+	n.blockManager.SetVerifier(verifier)
+}
+
+// SetKeyProvider enables encrypted transactions mode, where clients submit encrypted transactions, sequencer commits
+// to their ordering, and transactions are executed after decryption keys are revealed by the key provider.
+//
+// It has to be called before the node is started. It's a no-op in rpc-only mode.
+func (n *FullNode) SetKeyProvider(keyProvider state.KeyProvider) {
+	if n.nodeConfig.RPCOnly {
+		return
+	}
+	n.blockManager.SetKeyProvider(keyProvider)
+	if mp, ok := n.Mempool.(*mempool.CListMempool); ok {
+		mp.EnableEncryptedTxs()
+	}
+}
+
+// p2pInfo returns ID, listen address and network of the node. Node in rpc-only mode doesn't listen.
+func (n *FullNode) p2pInfo() (corep2p.ID, string, string, error) {
+	if n.nodeConfig.RPCOnly {
+		id, err := p2p.NodeID(n.p2pKey)
+		return id, "", n.genesis.ChainID, err
+	}
+	return n.p2pClient.Info()
+}
+
 // GetGenesis returns entire genesis doc.
 func (n *FullNode) GetGenesis() *cmtypes.GenesisDoc {
 	return n.genesis
@@ -420,17 +758,28 @@ func (n *FullNode) GetGenesisChunks() ([]string, error) {
 func (n *FullNode) OnStop() {
 	n.Logger.Info("halting full node...")
 	n.Logger.Info("shutting down full node sub services...")
-	err := errors.Join(
-		n.p2pClient.Close(),
-		n.hSyncService.Stop(),
-		n.bSyncService.Stop(),
-		n.IndexerService.Stop(),
-	)
+	var err error
+	if n.standby {
+		// block production is stopped first, so produced blocks are still published
+		err = n.blockManager.ReleaseLease(context.Background())
+	}
+	if !n.nodeConfig.RPCOnly {
+		err = errors.Join(
+			err,
+			n.p2pClient.Close(),
+			n.hSyncService.Stop(),
+			n.bSyncService.Stop(),
+		)
+	}
+	err = errors.Join(err, n.IndexerService.Stop())
 	if n.prometheusSrv != nil {
 		err = errors.Join(err, n.prometheusSrv.Shutdown(n.ctx))
 	}
 	n.cancel()
 	n.threadManager.Wait()
+	if client, ok := n.executor.(*executorgrpc.Client); ok {
+		err = errors.Join(err, client.Stop())
+	}
 	err = errors.Join(err, n.Store.Close())
 	n.Logger.Error("errors while stopping node:", "errors", err)
 }
@@ -455,13 +804,21 @@ func (n *FullNode) EventBus() *cmtypes.EventBus {
 	return n.eventBus
 }
 
-// AppClient returns ABCI proxy connections to communicate with application.
+// AppClient returns ABCI proxy connections to communicate with application, or nil in rpc-only mode.
 func (n *FullNode) AppClient() proxy.AppConns {
 	return n.proxyApp
 }
 
+// usesRemoteExecutor returns true if transactions are executed by remote executor. Transactions are then taken from
+// the remote executor, not from the mempool of the node.
+func (n *FullNode) usesRemoteExecutor() bool {
+	return n.nodeConfig.ExecutorAddress != ""
+}
+
 // newTxValidator creates a pubsub validator that uses the node's mempool to check the
 // transaction. If the transaction is valid, then it is added to the mempool
+//
+// Transactions are rejected if node uses remote executor, because mempool is not used.
 func (n *FullNode) newTxValidator(metrics *p2p.Metrics) p2p.GossipValidator {
 	return func(m *p2p.GossipMessage) bool {
 		n.Logger.Debug("transaction received", "bytes", len(m.Data))
@@ -472,6 +829,9 @@ func (n *FullNode) newTxValidator(metrics *p2p.Metrics) p2p.GossipValidator {
 		}
 		metrics.PeerReceiveBytesTotal.With(labels...).Add(float64(len(msgBytes)))
 		metrics.MessageReceiveBytesTotal.With("message_type", "tx").Add(float64(len(msgBytes)))
+		if n.usesRemoteExecutor() {
+			return false
+		}
 		checkTxResCh := make(chan *abci.ResponseCheckTx, 1)
 		err := n.Mempool.CheckTx(m.Data, func(resp *abci.ResponseCheckTx) {
 			select {
@@ -492,12 +852,60 @@ func (n *FullNode) newTxValidator(metrics *p2p.Metrics) p2p.GossipValidator {
 			return false
 		case errors.Is(err, mempool.ErrPreCheck{}):
 			return false
-		default:
+		case err != nil:
+			// e.g. block.ErrNodeOverloaded or mempool.ErrDecryptionKeysTx, the callback is not called
+			n.Logger.Debug("transaction rejected", "error", err)
+			return false
 		}
-		checkTxResp := <-checkTxResCh
+		select {
+		case <-n.ctx.Done():
+			return false
+		case checkTxResp := <-checkTxResCh:
+			return checkTxResp.Code == abci.CodeTypeOK
+		}
+	}
+}
 
-		return checkTxResp.Code == abci.CodeTypeOK
+// initStore creates the main store of the node, upgrades its schema and, if enabled, wraps it with cache.
+//
+// In rpc-only mode, the store can't be upgraded, so it's only checked.
+func initStore(ctx context.Context, kv ds.TxnDatastore, nodeConfig config.NodeConfig, metrics *store.Metrics, logger log.Logger) (store.Store, error) {
+	s := store.New(kv)
+	if nodeConfig.RPCOnly {
+		if err := checkReadOnlyStore(ctx, s); err != nil {
+			return nil, err
+		}
+	} else if err := migrateStore(ctx, s, logger); err != nil {
+		return nil, err
 	}
+	if nodeConfig.StoreCacheSize <= 0 {
+		return s, nil
+	}
+	return store.NewCachedStore(s, nodeConfig.StoreCacheSize, metrics)
+}
+
+// migrateStore upgrades schema of the store to the version supported by the node.
+func migrateStore(ctx context.Context, s store.Store, logger log.Logger) error {
+	migrations, err := store.Migrate(ctx, s, false)
+	for _, m := range migrations {
+		logger.Info("applied store migration", "version", m.Version, "description", m.Description)
+	}
+	return err
+}
+
+// checkReadOnlyStore checks that the store served in rpc-only mode is initialized and doesn't need an upgrade.
+func checkReadOnlyStore(ctx context.Context, s store.Store) error {
+	if _, err := s.GetState(ctx); err != nil {
+		return fmt.Errorf("failed to load the state, rpc-only mode requires initialized store: %w", err)
+	}
+	version, err := store.GetSchemaVersion(ctx, s)
+	if err != nil {
+		return err
+	}
+	if version != store.SchemaVersion {
+		return fmt.Errorf("store schema version %d is not supported in rpc-only mode (expected %d), upgrade the store", version, store.SchemaVersion)
+	}
+	return nil
 }
 
 func newPrefixKV(kvStore ds.Datastore, prefix string) ds.TxnDatastore {
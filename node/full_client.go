@@ -18,8 +18,11 @@ import (
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	cmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cometbft/cometbft/version"
+	ds "github.com/ipfs/go-datastore"
 
+	"github.com/rollkit/rollkit/block"
 	rconfig "github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/evidence"
 	"github.com/rollkit/rollkit/mempool"
 	"github.com/rollkit/rollkit/types"
 	abciconv "github.com/rollkit/rollkit/types/abci"
@@ -36,6 +39,12 @@ const (
 var (
 	// ErrConsensusStateNotAvailable is returned because Rollkit doesn't use Tendermint consensus.
 	ErrConsensusStateNotAvailable = errors.New("consensus state not available in Rollkit")
+	// ErrRPCOnly is returned for operations requiring P2P networking, the application or block production in rpc-only
+	// mode.
+	ErrRPCOnly = errors.New("not available in rpc-only mode")
+	// ErrRemoteExecutor is returned when transaction is submitted to the node using remote executor. Transactions are
+	// taken from the remote executor, so they have to be submitted directly to it.
+	ErrRemoteExecutor = errors.New("transactions have to be submitted to the remote executor")
 )
 
 var _ rpcclient.Client = &FullClient{}
@@ -60,6 +69,9 @@ func NewFullClient(node *FullNode) *FullClient {
 
 // ABCIInfo returns basic information about application state.
 func (c *FullClient) ABCIInfo(ctx context.Context) (*ctypes.ResultABCIInfo, error) {
+	if c.node.nodeConfig.RPCOnly {
+		return nil, ErrRPCOnly
+	}
 	resInfo, err := c.appClient().Query().Info(ctx, proxy.RequestInfo)
 	if err != nil {
 		return nil, err
@@ -74,6 +86,9 @@ func (c *FullClient) ABCIQuery(ctx context.Context, path string, data cmbytes.He
 
 // ABCIQueryWithOptions queries for data from application.
 func (c *FullClient) ABCIQueryWithOptions(ctx context.Context, path string, data cmbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	if c.node.nodeConfig.RPCOnly {
+		return nil, ErrRPCOnly
+	}
 	resQuery, err := c.appClient().Query().Query(ctx, &abci.RequestQuery{
 		Path:   path,
 		Data:   data,
@@ -90,15 +105,25 @@ func (c *FullClient) ABCIQueryWithOptions(ctx context.Context, path string, data
 // BroadcastTxCommit returns with the responses from CheckTx and DeliverTx.
 // More: https://docs.tendermint.com/master/rpc/#/Tx/broadcast_tx_commit
 func (c *FullClient) BroadcastTxCommit(ctx context.Context, tx cmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	res, _, err := c.BroadcastTxCommitWithPreConfirmation(ctx, tx)
+	return res, err
+}
+
+// BroadcastTxCommitWithPreConfirmation works like BroadcastTxCommit, but additionally returns signed
+// pre-confirmation of the transaction, if node is an aggregator with pre-confirmations enabled.
+func (c *FullClient) BroadcastTxCommitWithPreConfirmation(ctx context.Context, tx cmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, *types.PreConfirmation, error) {
+	if c.node.usesRemoteExecutor() {
+		return nil, nil, ErrRemoteExecutor
+	}
 	// This implementation corresponds to Tendermints implementation from rpc/core/mempool.go.
 	// ctx.RemoteAddr godoc: If neither HTTPReq nor WSConn is set, an empty string is returned.
 	// This code is a local client, so we can assume that subscriber is ""
 	subscriber := "" //ctx.RemoteAddr()
 
 	if c.EventBus.NumClients() >= c.config.MaxSubscriptionClients {
-		return nil, fmt.Errorf("max_subscription_clients %d reached", c.config.MaxSubscriptionClients)
+		return nil, nil, fmt.Errorf("max_subscription_clients %d reached", c.config.MaxSubscriptionClients)
 	} else if c.EventBus.NumClientSubscriptions(subscriber) >= c.config.MaxSubscriptionsPerClient {
-		return nil, fmt.Errorf("max_subscriptions_per_client %d reached", c.config.MaxSubscriptionsPerClient)
+		return nil, nil, fmt.Errorf("max_subscriptions_per_client %d reached", c.config.MaxSubscriptionsPerClient)
 	}
 
 	// Subscribe to tx being committed in block.
@@ -109,7 +134,7 @@ func (c *FullClient) BroadcastTxCommit(ctx context.Context, tx cmtypes.Tx) (*cty
 	if err != nil {
 		err = fmt.Errorf("failed to subscribe to tx: %w", err)
 		c.Logger.Error("Error on broadcast_tx_commit", "err", err)
-		return nil, err
+		return nil, nil, err
 	}
 	defer func() {
 		if err := c.EventBus.Unsubscribe(ctx, subscriber, q); err != nil {
@@ -128,7 +153,7 @@ func (c *FullClient) BroadcastTxCommit(ctx context.Context, tx cmtypes.Tx) (*cty
 	}, mempool.TxInfo{})
 	if err != nil {
 		c.Logger.Error("Error on broadcastTxCommit", "err", err)
-		return nil, fmt.Errorf("error on broadcastTxCommit: %w", err)
+		return nil, nil, fmt.Errorf("error on broadcastTxCommit: %w", err)
 	}
 	checkTxRes := <-checkTxResCh
 	if checkTxRes.Code != abci.CodeTypeOK {
@@ -136,13 +161,14 @@ func (c *FullClient) BroadcastTxCommit(ctx context.Context, tx cmtypes.Tx) (*cty
 			CheckTx:  *checkTxRes,
 			TxResult: abci.ExecTxResult{},
 			Hash:     tx.Hash(),
-		}, nil
+		}, nil, nil
 	}
+	preConfirmation := c.preConfirm(tx)
 
 	// broadcast tx
 	err = c.node.p2pClient.GossipTx(ctx, tx)
 	if err != nil {
-		return nil, fmt.Errorf("tx added to local mempool but failure to broadcast: %w", err)
+		return nil, nil, fmt.Errorf("tx added to local mempool but failure to broadcast: %w", err)
 	}
 
 	// Wait for the tx to be included in a block or timeout.
@@ -154,7 +180,7 @@ func (c *FullClient) BroadcastTxCommit(ctx context.Context, tx cmtypes.Tx) (*cty
 			TxResult: deliverTxRes.Result,
 			Hash:     tx.Hash(),
 			Height:   deliverTxRes.Height,
-		}, nil
+		}, preConfirmation, nil
 	case <-deliverTxSub.Canceled():
 		var reason string
 		if deliverTxSub.Err() == nil {
@@ -168,7 +194,7 @@ func (c *FullClient) BroadcastTxCommit(ctx context.Context, tx cmtypes.Tx) (*cty
 			CheckTx:  *checkTxRes,
 			TxResult: abci.ExecTxResult{},
 			Hash:     tx.Hash(),
-		}, err
+		}, preConfirmation, err
 	case <-time.After(c.config.TimeoutBroadcastTxCommit):
 		err = errors.New("timed out waiting for tx to be included in a block")
 		c.Logger.Error("Error on broadcastTxCommit", "err", err)
@@ -176,7 +202,7 @@ func (c *FullClient) BroadcastTxCommit(ctx context.Context, tx cmtypes.Tx) (*cty
 			CheckTx:  *checkTxRes,
 			TxResult: abci.ExecTxResult{},
 			Hash:     tx.Hash(),
-		}, err
+		}, preConfirmation, err
 	}
 }
 
@@ -184,22 +210,58 @@ func (c *FullClient) BroadcastTxCommit(ctx context.Context, tx cmtypes.Tx) (*cty
 // CheckTx nor DeliverTx results.
 // More: https://docs.tendermint.com/master/rpc/#/Tx/broadcast_tx_async
 func (c *FullClient) BroadcastTxAsync(ctx context.Context, tx cmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
-	err := c.node.Mempool.CheckTx(tx, nil, mempool.TxInfo{})
+	res, _, err := c.BroadcastTxAsyncWithPreConfirmation(ctx, tx)
+	return res, err
+}
+
+// BroadcastTxAsyncWithPreConfirmation works like BroadcastTxAsync, but additionally returns signed pre-confirmation
+// of the transaction, if node is an aggregator with pre-confirmations enabled. Pre-confirmation is issued only for
+// transaction accepted to mempool, so it's returned only if CheckTx result is available without waiting (e.g. with
+// local ABCI client).
+func (c *FullClient) BroadcastTxAsyncWithPreConfirmation(ctx context.Context, tx cmtypes.Tx) (*ctypes.ResultBroadcastTx, *types.PreConfirmation, error) {
+	if c.node.usesRemoteExecutor() {
+		return nil, nil, ErrRemoteExecutor
+	}
+	resCh := make(chan *abci.ResponseCheckTx, 1)
+	err := c.node.Mempool.CheckTx(tx, func(res *abci.ResponseCheckTx) {
+		select {
+		case resCh <- res:
+		default:
+		}
+	}, mempool.TxInfo{})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	// gossipTx optimistically
 	err = c.node.p2pClient.GossipTx(ctx, tx)
 	if err != nil {
-		return nil, fmt.Errorf("tx added to local mempool but failed to gossip: %w", err)
+		return nil, nil, fmt.Errorf("tx added to local mempool but failed to gossip: %w", err)
+	}
+	var preConfirmation *types.PreConfirmation
+	select {
+	case res := <-resCh:
+		if res.Code == abci.CodeTypeOK {
+			preConfirmation = c.preConfirm(tx)
+		}
+	default:
 	}
-	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, preConfirmation, nil
 }
 
 // BroadcastTxSync returns with the response from CheckTx. Does not wait for
 // DeliverTx result.
 // More: https://docs.tendermint.com/master/rpc/#/Tx/broadcast_tx_sync
 func (c *FullClient) BroadcastTxSync(ctx context.Context, tx cmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	res, _, err := c.BroadcastTxSyncWithPreConfirmation(ctx, tx)
+	return res, err
+}
+
+// BroadcastTxSyncWithPreConfirmation works like BroadcastTxSync, but additionally returns signed pre-confirmation of
+// the transaction, if node is an aggregator with pre-confirmations enabled.
+func (c *FullClient) BroadcastTxSyncWithPreConfirmation(ctx context.Context, tx cmtypes.Tx) (*ctypes.ResultBroadcastTx, *types.PreConfirmation, error) {
+	if c.node.usesRemoteExecutor() {
+		return nil, nil, ErrRemoteExecutor
+	}
 	resCh := make(chan *abci.ResponseCheckTx, 1)
 	err := c.node.Mempool.CheckTx(tx, func(res *abci.ResponseCheckTx) {
 		select {
@@ -209,13 +271,14 @@ func (c *FullClient) BroadcastTxSync(ctx context.Context, tx cmtypes.Tx) (*ctype
 		}
 	}, mempool.TxInfo{})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	res := <-resCh
 
 	// gossip the transaction if it's in the mempool.
 	// Note: we have to do this here because, unlike the tendermint mempool reactor, there
 	// is no routine that gossips transactions after they enter the pool
+	var preConfirmation *types.PreConfirmation
 	if res.Code == abci.CodeTypeOK {
 		err = c.node.p2pClient.GossipTx(ctx, tx)
 		if err != nil {
@@ -224,8 +287,9 @@ func (c *FullClient) BroadcastTxSync(ctx context.Context, tx cmtypes.Tx) (*ctype
 			// this node, as the CheckTx call above will return an error indicating that
 			// the tx is already in the mempool
 			_ = c.node.Mempool.RemoveTxByKey(tx.Key())
-			return nil, fmt.Errorf("failed to gossip tx: %w", err)
+			return nil, nil, fmt.Errorf("failed to gossip tx: %w", err)
 		}
+		preConfirmation = c.preConfirm(tx)
 	}
 
 	return &ctypes.ResultBroadcastTx{
@@ -234,7 +298,37 @@ func (c *FullClient) BroadcastTxSync(ctx context.Context, tx cmtypes.Tx) (*ctype
 		Log:       res.Log,
 		Codespace: res.Codespace,
 		Hash:      tx.Hash(),
-	}, nil
+	}, preConfirmation, nil
+}
+
+// preConfirm returns signed pre-confirmation of transaction accepted to mempool, or nil if pre-confirmations are not
+// issued by this node.
+func (c *FullClient) preConfirm(tx cmtypes.Tx) *types.PreConfirmation {
+	if !c.node.nodeConfig.Aggregator || c.node.nodeConfig.PreConfirmationWindow == 0 {
+		return nil
+	}
+	preConfirmation, err := c.node.blockManager.PreConfirm(tx.Hash())
+	if err != nil {
+		c.Logger.Error("failed to pre-confirm tx", "hash", tx.Hash(), "error", err)
+		return nil
+	}
+	return preConfirmation
+}
+
+// AddPreConfirmation verifies pre-confirmation issued by the sequencer and checks if it's honored by synced blocks.
+func (c *FullClient) AddPreConfirmation(ctx context.Context, preConfirmation *types.PreConfirmation) error {
+	if c.node.nodeConfig.RPCOnly {
+		return ErrRPCOnly
+	}
+	return c.node.blockManager.AddPreConfirmation(ctx, preConfirmation)
+}
+
+// BrokenPreConfirmation returns evidence of sequencer not honoring pre-confirmation of transaction with given hash.
+func (c *FullClient) BrokenPreConfirmation(ctx context.Context, txHash []byte) (*block.BrokenPreConfirmation, error) {
+	if c.node.nodeConfig.RPCOnly {
+		return nil, ErrRPCOnly
+	}
+	return c.node.blockManager.GetBrokenPreConfirmation(ctx, txHash)
 }
 
 // Subscribe subscribe given subscriber to a query.
@@ -321,19 +415,17 @@ func (c *FullClient) BlockchainInfo(ctx context.Context, minHeight, maxHeight in
 	}
 	c.Logger.Debug("BlockchainInfo", "maxHeight", maxHeight, "minHeight", minHeight)
 
-	blocks := make([]*cmtypes.BlockMeta, 0, maxHeight-minHeight+1)
-	for height := maxHeight; height >= minHeight; height-- {
-		block, err := c.node.Store.GetBlock(ctx, uint64(height))
+	stored, err := c.node.Store.GetBlocks(ctx, uint64(minHeight), uint64(maxHeight))
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]*cmtypes.BlockMeta, 0, len(stored))
+	for i := len(stored) - 1; i >= 0; i-- {
+		cmblockmeta, err := abciconv.ToABCIBlockMeta(stored[i])
 		if err != nil {
 			return nil, err
 		}
-		if block != nil {
-			cmblockmeta, err := abciconv.ToABCIBlockMeta(block)
-			if err != nil {
-				return nil, err
-			}
-			blocks = append(blocks, cmblockmeta)
-		}
+		blocks = append(blocks, cmblockmeta)
 	}
 
 	return &ctypes.ResultBlockchainInfo{
@@ -344,7 +436,12 @@ func (c *FullClient) BlockchainInfo(ctx context.Context, minHeight, maxHeight in
 }
 
 // NetInfo returns basic information about client P2P connections.
+//
+// Node in rpc-only mode doesn't use P2P network, so it's not listening and has no peers.
 func (c *FullClient) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
+	if c.node.nodeConfig.RPCOnly {
+		return &ctypes.ResultNetInfo{}, nil
+	}
 	res := ctypes.ResultNetInfo{
 		Listening: true,
 	}
@@ -376,39 +473,105 @@ func (c *FullClient) ConsensusState(ctx context.Context) (*ctypes.ResultConsensu
 }
 
 // ConsensusParams returns consensus params at given height.
+//
+// If params for the height are not available (e.g. they were not recorded by older versions), params from the latest
+// state are returned.
 func (c *FullClient) ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
-	state, err := c.node.Store.GetState(ctx)
+	h := c.normalizeHeight(height)
+	params, err := c.node.Store.GetConsensusParams(ctx, h)
+	if errors.Is(err, ds.ErrNotFound) {
+		state, stateErr := c.node.Store.GetState(ctx)
+		if stateErr != nil {
+			return nil, stateErr
+		}
+		params, err = &state.ConsensusParams, nil
+	}
 	if err != nil {
 		return nil, err
 	}
-	params := state.ConsensusParams
 	return &ctypes.ResultConsensusParams{
-		BlockHeight: int64(c.normalizeHeight(height)),
-		ConsensusParams: cmtypes.ConsensusParams{
-			Block: cmtypes.BlockParams{
-				MaxBytes: params.Block.MaxBytes,
-				MaxGas:   params.Block.MaxGas,
-			},
-			Evidence: cmtypes.EvidenceParams{
-				MaxAgeNumBlocks: params.Evidence.MaxAgeNumBlocks,
-				MaxAgeDuration:  params.Evidence.MaxAgeDuration,
-				MaxBytes:        params.Evidence.MaxBytes,
-			},
-			Validator: cmtypes.ValidatorParams{
-				PubKeyTypes: params.Validator.PubKeyTypes,
-			},
-			Version: cmtypes.VersionParams{
-				App: params.Version.App,
-			},
-		},
+		BlockHeight:     int64(h),
+		ConsensusParams: types.ConsensusParamsFromProto(*params),
 	}, nil
 }
 
 // Health endpoint returns empty value. It can be used to monitor service availability.
+//
+// Error is returned if the node halted, or sequencer was detected publishing conflicting blocks or stalled.
 func (c *FullClient) Health(ctx context.Context) (*ctypes.ResultHealth, error) {
+	if report := c.HaltReport(); report != nil {
+		return nil, fmt.Errorf("%w at height %d: %s", block.ErrHalted, report.Height, report.Error)
+	}
+	if !c.node.nodeConfig.RPCOnly && c.node.blockManager.IsSequencerFaulty() {
+		return nil, block.ErrSequencerDivergence
+	}
+	if liveness := c.SequencerLiveness(); liveness != nil && liveness.Stalled() {
+		return nil, fmt.Errorf("%w: last header at %s, last DA inclusion at %s", block.ErrSequencerStalled, liveness.LastHeaderTime, liveness.LastDAIncludedTime)
+	}
 	return &ctypes.ResultHealth{}, nil
 }
 
+// SequencerLiveness returns how recently the sequencer was seen producing blocks.
+//
+// Sequencer is monitored only by syncing non-aggregator nodes, nil is returned on aggregator and in rpc-only mode.
+func (c *FullClient) SequencerLiveness() *block.SequencerLiveness {
+	if c.node.nodeConfig.Aggregator || c.node.nodeConfig.RPCOnly {
+		return nil
+	}
+	liveness := c.node.blockManager.SequencerLiveness()
+	return &liveness
+}
+
+// DABacklog returns the number of blocks pending DA submission.
+//
+// Blocks are submitted to DA only by the aggregator, nil is returned on other nodes.
+func (c *FullClient) DABacklog() *block.DABacklog {
+	if !c.node.nodeConfig.Aggregator {
+		return nil
+	}
+	backlog := c.node.blockManager.DABacklog()
+	return &backlog
+}
+
+// TxStatus returns the lifecycle status of transaction with given hash.
+//
+// Transactions that are no longer tracked, but were indexed, are reported as included.
+func (c *FullClient) TxStatus(ctx context.Context, hash []byte) (*mempool.TxStatus, error) {
+	if status, ok := c.node.txStatus.Get(hash); ok {
+		return &status, nil
+	}
+	res, err := c.node.TxIndexer.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("tx (%X) not found", hash)
+	}
+	return &mempool.TxStatus{
+		Hash:   hash,
+		Status: mempool.TxStatusIncluded,
+		Height: uint64(res.Height),
+	}, nil
+}
+
+// HaltReport returns the crash report if the node halted after failing to apply a block, or nil otherwise.
+//
+// Node in rpc-only mode doesn't apply blocks, so it never halts.
+func (c *FullClient) HaltReport() *block.HaltReport {
+	if c.node.nodeConfig.RPCOnly {
+		return nil
+	}
+	return c.node.blockManager.HaltReport()
+}
+
+// LastHaltReport returns the crash report of the current or last halt, or nil if the node never halted.
+func (c *FullClient) LastHaltReport(ctx context.Context) (*block.HaltReport, error) {
+	if c.node.nodeConfig.RPCOnly {
+		return nil, nil
+	}
+	return c.node.blockManager.LastHaltReport(ctx)
+}
+
 // Block method returns BlockID and block itself for given height.
 //
 // If height is nil, it returns information about last known block.
@@ -458,6 +621,25 @@ func (c *FullClient) BlockByHash(ctx context.Context, hash []byte) (*ctypes.Resu
 	}, nil
 }
 
+// BlockByTime returns BlockID and block itself for the last block with time not after the given time.
+func (c *FullClient) BlockByTime(ctx context.Context, t time.Time) (*ctypes.ResultBlock, error) {
+	block, err := c.node.Store.GetBlockByTime(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	abciBlock, err := abciconv.ToABCIBlock(block)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultBlock{
+		BlockID: cmtypes.BlockID{
+			Hash: cmbytes.HexBytes(block.Hash()),
+		},
+		Block: abciBlock,
+	}, nil
+}
+
 // BlockResults returns information about transactions, events and updates of validator set and consensus params.
 func (c *FullClient) BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
 	var h uint64
@@ -492,55 +674,76 @@ func (c *FullClient) Commit(ctx context.Context, height *int64) (*ctypes.ResultC
 	if err != nil {
 		return nil, err
 	}
-	b, err := c.node.Store.GetBlock(ctx, heightValue)
+	h, err := c.node.Store.GetHeader(ctx, heightValue)
 	if err != nil {
 		return nil, err
 	}
 
 	// we should have a single validator
-	if len(b.SignedHeader.Validators.Validators) == 0 {
+	if len(h.Validators.Validators) == 0 {
 		return nil, errors.New("empty validator set found in block")
 	}
 
-	val := b.SignedHeader.Validators.Validators[0].Address
-	commit := com.ToABCICommit(heightValue, b.Hash(), val, b.SignedHeader.Time())
+	val := h.Validators.Validators[0].Address
+	commit := com.ToABCICommit(heightValue, h.Hash(), val, h.Time())
 
-	block, err := abciconv.ToABCIBlock(b)
+	header, err := abciconv.ToABCIHeader(&h.Header)
 	if err != nil {
 		return nil, err
 	}
 
-	return ctypes.NewResultCommit(&block.Header, commit, true), nil
+	return ctypes.NewResultCommit(&header, commit, true), nil
 }
 
 // Validators returns paginated list of validators at given height.
+//
+// Validator sets are saved when they change, so the validator set in effect at the given height is returned. If the
+// validator set for the height is not available (e.g. it was not recorded by older versions), the validator set from
+// the header at the given height is returned, or from genesis if there is no such header.
 func (c *FullClient) Validators(ctx context.Context, heightPtr *int64, pagePtr, perPagePtr *int) (*ctypes.ResultValidators, error) {
 	height := c.normalizeHeight(heightPtr)
-	genesisValidators := c.node.GetGenesis().Validators
-
-	if len(genesisValidators) != 1 {
-		return nil, fmt.Errorf("there should be exactly one validator in genesis")
+	validators, err := c.node.Store.GetValidators(ctx, height)
+	if errors.Is(err, ds.ErrNotFound) {
+		validators, err = c.fallbackValidators(ctx, height)
 	}
-	// Since it's a centralized sequencer
-	// changed behavior to get this from genesis
-	genesisValidator := genesisValidators[0]
-	validator := cmtypes.Validator{
-		Address:          genesisValidator.Address,
-		PubKey:           genesisValidator.PubKey,
-		VotingPower:      int64(1),
-		ProposerPriority: int64(1),
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount := len(validators.Validators)
+	perPage := validatePerPage(perPagePtr)
+	page, err := validatePage(pagePtr, perPage, totalCount)
+	if err != nil {
+		return nil, err
 	}
+	skipCount := validateSkipCount(page, perPage)
+	pageValidators := validators.Validators[skipCount:min(skipCount+perPage, totalCount)]
 
 	return &ctypes.ResultValidators{
 		BlockHeight: int64(height),
-		Validators: []*cmtypes.Validator{
-			&validator,
-		},
-		Count: 1,
-		Total: 1,
+		Validators:  pageValidators,
+		Count:       len(pageValidators),
+		Total:       totalCount,
 	}, nil
 }
 
+// fallbackValidators returns validator set from the header at given height, or from genesis if the header is not found.
+func (c *FullClient) fallbackValidators(ctx context.Context, height uint64) (*cmtypes.ValidatorSet, error) {
+	header, err := c.node.Store.GetHeader(ctx, height)
+	if err == nil && header.Validators != nil {
+		return header.Validators, nil
+	}
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return nil, err
+	}
+	genesisValidators := c.node.GetGenesis().Validators
+	validators := make([]*cmtypes.Validator, 0, len(genesisValidators))
+	for _, v := range genesisValidators {
+		validators = append(validators, cmtypes.NewValidator(v.PubKey, v.Power))
+	}
+	return cmtypes.NewValidatorSet(validators), nil
+}
+
 // Tx returns detailed information about transaction identified by its hash.
 func (c *FullClient) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	res, err := c.node.TxIndexer.Get(hash)
@@ -707,12 +910,12 @@ func (c *FullClient) BlockSearch(ctx context.Context, query string, page, perPag
 
 // Status returns detailed information about current status of the node.
 func (c *FullClient) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
-	latest, err := c.node.Store.GetBlock(ctx, c.node.Store.Height())
+	latest, err := c.node.Store.GetHeader(ctx, c.node.Store.Height())
 	if err != nil {
 		return nil, fmt.Errorf("failed to find latest block: %w", err)
 	}
 
-	initial, err := c.node.Store.GetBlock(ctx, uint64(c.node.GetGenesis().InitialHeight))
+	initial, err := c.node.Store.GetHeader(ctx, uint64(c.node.GetGenesis().InitialHeight))
 	if err != nil {
 		return nil, fmt.Errorf("failed to find earliest block: %w", err)
 	}
@@ -741,7 +944,7 @@ func (c *FullClient) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
 		state.Version.Consensus.Block,
 		state.Version.Consensus.App,
 	)
-	id, addr, network, err := c.node.p2pClient.Info()
+	id, addr, network, err := c.node.p2pInfo()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load node p2p2 info: %w", err)
 	}
@@ -761,12 +964,12 @@ func (c *FullClient) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
 			},
 		},
 		SyncInfo: ctypes.SyncInfo{
-			LatestBlockHash:     cmbytes.HexBytes(latest.SignedHeader.DataHash),
-			LatestAppHash:       cmbytes.HexBytes(latest.SignedHeader.AppHash),
+			LatestBlockHash:     cmbytes.HexBytes(latest.DataHash),
+			LatestAppHash:       cmbytes.HexBytes(latest.AppHash),
 			LatestBlockHeight:   int64(latest.Height()),
 			LatestBlockTime:     latest.Time(),
-			EarliestBlockHash:   cmbytes.HexBytes(initial.SignedHeader.DataHash),
-			EarliestAppHash:     cmbytes.HexBytes(initial.SignedHeader.AppHash),
+			EarliestBlockHash:   cmbytes.HexBytes(initial.DataHash),
+			EarliestAppHash:     cmbytes.HexBytes(initial.AppHash),
 			EarliestBlockHeight: int64(initial.Height()),
 			EarliestBlockTime:   initial.Time(),
 			CatchingUp:          false, // hard-code this to "false" to pass Go IBC relayer's legacy encoding check
@@ -780,13 +983,28 @@ func (c *FullClient) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
 	return result, nil
 }
 
-// BroadcastEvidence is not yet implemented.
+// BroadcastEvidence verifies evidence of sequencer misbehavior and adds it to the evidence pool, to be included in a
+// block.
 func (c *FullClient) BroadcastEvidence(ctx context.Context, evidence cmtypes.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
+	if evidence == nil {
+		return nil, errors.New("no evidence was provided")
+	}
+	if c.node.nodeConfig.RPCOnly {
+		return nil, ErrRPCOnly
+	}
+	if err := c.node.blockManager.AddEvidence(ctx, evidence); err != nil {
+		return nil, fmt.Errorf("failed to add evidence: %w", err)
+	}
 	return &ctypes.ResultBroadcastEvidence{
 		Hash: evidence.Hash(),
 	}, nil
 }
 
+// Evidence returns pending or committed evidence with given hash.
+func (c *FullClient) Evidence(ctx context.Context, hash []byte) (*evidence.Info, error) {
+	return c.node.evpool.GetEvidence(ctx, hash)
+}
+
 // NumUnconfirmedTxs returns information about transactions in mempool.
 func (c *FullClient) NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirmedTxs, error) {
 	return &ctypes.ResultUnconfirmedTxs{
@@ -823,11 +1041,15 @@ func (c *FullClient) CheckTx(ctx context.Context, tx cmtypes.Tx) (*ctypes.Result
 
 // Header returns a cometbft ResultsHeader for the FullClient
 func (c *FullClient) Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error) {
-	blockMeta := c.getBlockMeta(ctx, *height)
-	if blockMeta == nil {
+	h, err := c.node.Store.GetHeader(ctx, uint64(*height))
+	if err != nil {
 		return nil, fmt.Errorf("block at height %d not found", *height)
 	}
-	return &ctypes.ResultHeader{Header: &blockMeta.Header}, nil
+	header, err := abciconv.ToABCIHeader(&h.Header)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultHeader{Header: &header}, nil
 }
 
 // HeaderByHash loads the block for the provided hash and returns the header
@@ -919,19 +1141,6 @@ func (c *FullClient) normalizeHeight(height *int64) uint64 {
 	return heightValue
 }
 
-func (rpc *FullClient) getBlockMeta(ctx context.Context, n int64) *cmtypes.BlockMeta {
-	b, err := rpc.node.Store.GetBlock(ctx, uint64(n))
-	if err != nil {
-		return nil
-	}
-	bmeta, err := abciconv.ToABCIBlockMeta(b)
-	if err != nil {
-		return nil
-	}
-
-	return bmeta
-}
-
 func validatePerPage(perPagePtr *int) int {
 	if perPagePtr == nil { // no per_page parameter
 		return defaultPerPage
@@ -23,12 +23,14 @@ import (
 	"github.com/cometbft/cometbft/proxy"
 	cmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cometbft/cometbft/version"
+	ds "github.com/ipfs/go-datastore"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/cometbft/cometbft/light"
 
 	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/store"
 	test "github.com/rollkit/rollkit/test/log"
 	"github.com/rollkit/rollkit/test/mocks"
 	"github.com/rollkit/rollkit/types"
@@ -516,6 +518,32 @@ func TestGetBlockByHash(t *testing.T) {
 	assert.NotNil(blockResp.Block)
 }
 
+func TestBlockByTime(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	_, rpc := getRPC(t)
+	block, privKey := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1})
+	next := types.GetRandomNextBlock(block, privKey, types.GetRandomBytes(32), 1)
+	for _, b := range []*types.Block{block, next} {
+		require.NoError(rpc.node.Store.SaveBlock(ctx, b, &b.SignedHeader.Commit))
+	}
+	rpc.node.Store.SetHeight(ctx, 2)
+
+	res, err := rpc.BlockByTime(ctx, next.Time().Add(-time.Nanosecond))
+	require.NoError(err)
+	assert.Equal(int64(1), res.Block.Height)
+	assert.Equal([]byte(block.Hash()), []byte(res.BlockID.Hash))
+
+	res, err = rpc.BlockByTime(ctx, next.Time())
+	require.NoError(err)
+	assert.Equal(int64(2), res.Block.Height)
+
+	_, err = rpc.BlockByTime(ctx, block.Time().Add(-time.Second))
+	assert.Error(err)
+}
+
 func finalizeBlockResponse(_ context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
 	txResults := make([]*abci.ExecTxResult, len(req.Txs))
 	for idx := range req.Txs {
@@ -1070,3 +1098,147 @@ func TestNetInfo(t *testing.T) {
 	assert.True(netInfo.Listening)
 	assert.Equal(0, len(netInfo.Peers))
 }
+
+func TestBroadcastEvidence(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	app := &mocks.Application{}
+	app.On("InitChain", mock.Anything, mock.Anything).Return(&abci.ResponseInitChain{}, nil)
+	key, _, _ := crypto.GenerateEd25519Key(crand.Reader)
+	genesisDoc, genesisValidatorKey := types.GetGenesisWithPrivkey()
+	signingKey, err := types.PrivKeyToSigningKey(genesisValidatorKey)
+	require.NoError(err)
+	node, err := newFullNode(ctx, config.NodeConfig{DAAddress: MockDAAddress, DANamespace: MockDANamespace}, key, signingKey, proxy.NewLocalClientCreator(app), genesisDoc, DefaultMetricsProvider(cmconfig.DefaultInstrumentationConfig()), log.TestingLogger())
+	require.NoError(err)
+	// evidence is gossiped, so P2P client has to be started
+	startNodeWithCleanup(t, node)
+	rpc := NewFullClient(node)
+
+	first, err := types.GetRandomSignedHeaderCustom(&types.HeaderConfig{Height: 3, DataHash: types.GetRandomBytes(32), PrivKey: genesisValidatorKey})
+	require.NoError(err)
+	second, err := types.GetRandomSignedHeaderCustom(&types.HeaderConfig{Height: 3, DataHash: types.GetRandomBytes(32), PrivKey: genesisValidatorKey})
+	require.NoError(err)
+	ev, err := types.NewDuplicateVoteEvidence(first, second)
+	require.NoError(err)
+
+	res, err := rpc.BroadcastEvidence(ctx, ev)
+	require.NoError(err)
+	assert.Equal(ev.Hash(), res.Hash)
+
+	info, err := rpc.Evidence(ctx, ev.Hash())
+	require.NoError(err)
+	assert.True(info.Pending)
+	assert.Equal(ev.Hash(), info.Evidence.Hash())
+
+	// evidence against other validator is rejected
+	other, err := types.GetRandomSignedHeaderCustom(&types.HeaderConfig{Height: 3, DataHash: types.GetRandomBytes(32), PrivKey: ed25519.GenPrivKey()})
+	require.NoError(err)
+	invalid, err := types.NewDuplicateVoteEvidence(first, other)
+	require.NoError(err)
+	_, err = rpc.BroadcastEvidence(ctx, invalid)
+	assert.ErrorIs(err, types.ErrInvalidEvidence)
+}
+
+func TestConsensusParams(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+	_, rpc := getRPC(t)
+
+	state, err := rpc.node.Store.GetState(ctx)
+	require.NoError(err)
+	require.Equal(uint64(1), state.LastHeightConsensusParamsChanged)
+	initialMaxBytes := state.ConsensusParams.Block.MaxBytes
+
+	state.LastBlockHeight = 10
+	state.LastHeightConsensusParamsChanged = 5
+	state.ConsensusParams.Block.MaxBytes = 1234
+	state.ConsensusParams.Abci.VoteExtensionsEnableHeight = 8
+	require.NoError(rpc.node.Store.UpdateState(ctx, state))
+	rpc.node.Store.SetHeight(ctx, 10)
+
+	height := int64(3)
+	res, err := rpc.ConsensusParams(ctx, &height)
+	require.NoError(err)
+	assert.Equal(height, res.BlockHeight)
+	assert.Equal(initialMaxBytes, res.ConsensusParams.Block.MaxBytes)
+	assert.Equal(int64(0), res.ConsensusParams.ABCI.VoteExtensionsEnableHeight)
+
+	res, err = rpc.ConsensusParams(ctx, nil)
+	require.NoError(err)
+	assert.Equal(int64(10), res.BlockHeight)
+	assert.Equal(int64(1234), res.ConsensusParams.Block.MaxBytes)
+	assert.Equal(int64(8), res.ConsensusParams.ABCI.VoteExtensionsEnableHeight)
+}
+
+func TestValidators(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+	_, rpc := getRPC(t)
+
+	// validator set changes at height 2
+	block := types.GetRandomBlock(1, 1)
+	next := types.GetRandomBlock(2, 1)
+	next.SignedHeader.Validators = cmtypes.NewValidatorSet([]*cmtypes.Validator{
+		cmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 1),
+		cmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 1),
+		cmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 1),
+	})
+	for _, b := range []*types.Block{block, next} {
+		require.NoError(rpc.node.Store.SaveBlock(ctx, b, &b.SignedHeader.Commit))
+	}
+	rpc.node.Store.SetHeight(ctx, 2)
+
+	height := int64(1)
+	res, err := rpc.Validators(ctx, &height, nil, nil)
+	require.NoError(err)
+	assert.Equal(height, res.BlockHeight)
+	assert.Equal(block.SignedHeader.Validators.Validators, res.Validators)
+	assert.Equal(len(block.SignedHeader.Validators.Validators), res.Total)
+
+	page, perPage := 2, 2
+	res, err = rpc.Validators(ctx, nil, &page, &perPage)
+	require.NoError(err)
+	assert.Equal(int64(2), res.BlockHeight)
+	assert.Equal(3, res.Total)
+	assert.Equal(1, res.Count)
+	assert.Equal(next.SignedHeader.Validators.Validators[2:], res.Validators)
+
+	page = 3
+	_, err = rpc.Validators(ctx, nil, &page, &perPage)
+	assert.Error(err)
+}
+
+// legacyValidatorsStore simulates a store without validator sets, saved only by newer versions.
+type legacyValidatorsStore struct {
+	store.Store
+}
+
+func (s legacyValidatorsStore) GetValidators(_ context.Context, height uint64) (*cmtypes.ValidatorSet, error) {
+	return nil, fmt.Errorf("failed to load validator set for height %v: %w", height, ds.ErrNotFound)
+}
+
+func TestValidatorsFallback(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+	_, rpc := getRPC(t)
+	rpc.node.Store = legacyValidatorsStore{rpc.node.Store}
+
+	// genesis validators are returned before any block is saved
+	res, err := rpc.Validators(ctx, nil, nil, nil)
+	require.NoError(err)
+	genesisValidators := rpc.node.GetGenesis().Validators
+	require.Len(res.Validators, len(genesisValidators))
+	assert.Equal(genesisValidators[0].Address, res.Validators[0].Address)
+
+	block := types.GetRandomBlock(1, 1)
+	require.NoError(rpc.node.Store.SaveBlock(ctx, block, &block.SignedHeader.Commit))
+	rpc.node.Store.SetHeight(ctx, 1)
+	res, err = rpc.Validators(ctx, nil, nil, nil)
+	require.NoError(err)
+	assert.Equal(block.SignedHeader.Validators.Validators, res.Validators)
+}
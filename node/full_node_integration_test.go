@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	mrand "math/rand"
+	"net"
 	"strconv"
 	"strings"
 	"testing"
@@ -14,6 +15,7 @@ import (
 
 	cmconfig "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	ds "github.com/ipfs/go-datastore"
 	"github.com/stretchr/testify/assert"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -24,11 +26,15 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	goDA "github.com/rollkit/go-da"
 	"github.com/rollkit/rollkit/block"
 	"github.com/rollkit/rollkit/config"
 	"github.com/rollkit/rollkit/da"
+	"github.com/rollkit/rollkit/state"
+	executorgrpc "github.com/rollkit/rollkit/state/grpc"
 	test "github.com/rollkit/rollkit/test/log"
 	"github.com/rollkit/rollkit/test/mocks"
 	"github.com/rollkit/rollkit/types"
@@ -377,6 +383,74 @@ func TestHeaderExchange(t *testing.T) {
 	t.Run("SingleAggregatorSingleFullNodeSingleLightNode", testSingleAggregatorSingleFullNodeSingleLightNode)
 }
 
+func TestOptimisticExecution(t *testing.T) {
+	require := require.New(t)
+
+	aggCtx, aggCancel := context.WithCancel(context.Background())
+	defer aggCancel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bmConfig := getBMConfig()
+	bmConfig.OptimisticExecution = true
+	nodes, _ := createNodes(aggCtx, ctx, 2, bmConfig, types.TestChainID, t)
+
+	startNodeWithCleanup(t, nodes[0])
+	require.NoError(waitForFirstBlock(nodes[0], Store))
+	startNodeWithCleanup(t, nodes[1])
+	require.NoError(waitForAtLeastNBlocks(nodes[1], 3, Store))
+	require.NoError(verifyNodesSynced(nodes[0], nodes[1], Store))
+}
+
+func TestEvidenceGossip(t *testing.T) {
+	require := require.New(t)
+
+	aggCtx, aggCancel := context.WithCancel(context.Background())
+	defer aggCancel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	keys := make([]crypto.PrivKey, 2)
+	for i := range keys {
+		keys[i], _, _ = crypto.GenerateEd25519Key(rand.Reader)
+	}
+	dalc := getMockDA(t)
+	aggregator, _ := createAndConfigureNode(aggCtx, 0, true, false, keys, getBMConfig(), dalc, t)
+	fullNode, _ := createAndConfigureNode(ctx, 1, false, false, keys, getBMConfig(), dalc, t)
+	seq, full := aggregator.(*FullNode), fullNode.(*FullNode)
+
+	startNodeWithCleanup(t, seq)
+	require.NoError(waitForFirstBlock(seq, Store))
+	startNodeWithCleanup(t, full)
+	require.NoError(waitForAtLeastNBlocks(full, 2, Store))
+
+	// evidence against the sequencer, submitted to the full node
+	raw, err := keys[0].Raw()
+	require.NoError(err)
+	seqKey := ed25519.PrivKey(raw)
+	first, err := types.GetRandomSignedHeaderCustom(&types.HeaderConfig{Height: 1, DataHash: types.GetRandomBytes(32), PrivKey: seqKey})
+	require.NoError(err)
+	second, err := types.GetRandomSignedHeaderCustom(&types.HeaderConfig{Height: 1, DataHash: types.GetRandomBytes(32), PrivKey: seqKey})
+	require.NoError(err)
+	ev, err := types.NewDuplicateVoteEvidence(first, second)
+	require.NoError(err)
+	_, err = NewFullClient(full).BroadcastEvidence(ctx, ev)
+	require.NoError(err)
+
+	// evidence is gossiped to the sequencer and included in a block, which is checked by the full node
+	require.NoError(testutils.Retry(300, 100*time.Millisecond, func() error {
+		for _, node := range []*FullNode{seq, full} {
+			info, err := node.evpool.GetEvidence(ctx, ev.Hash())
+			if err != nil {
+				return err
+			}
+			if info.Pending {
+				return errors.New("evidence not committed yet")
+			}
+		}
+		return nil
+	}))
+}
+
 func TestSubmitBlocksToDA(t *testing.T) {
 	require := require.New(t)
 
@@ -613,6 +687,139 @@ func doTestMaxPending(maxPending uint64, t *testing.T) {
 	require.NoError(waitForAtLeastNBlocks(seq, int(maxPending+1), Store))
 }
 
+func TestHotStandbyFailover(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	keys := make([]crypto.PrivKey, 2)
+	for i := range keys {
+		keys[i], _, _ = crypto.GenerateEd25519Key(rand.Reader)
+	}
+	bmConfig := getBMConfig()
+	bmConfig.LeaseTTL = 2 * time.Second
+	dalc := getMockDA(t)
+	lease := block.NewKVLeaseBackend(ds.NewMapDatastore())
+
+	node, _ := createNode(ctx, 0, true, false, keys, bmConfig, types.TestChainID, t)
+	active := node.(*FullNode)
+	active.dalc = dalc
+	active.blockManager.SetDALC(dalc)
+	require.NoError(active.SetLeaseBackend(lease))
+
+	// standby uses the same signing key, but different P2P identity
+	activeID, err := peer.IDFromPrivateKey(keys[0])
+	require.NoError(err)
+	node, err = NewNode(
+		ctx,
+		config.NodeConfig{
+			DAAddress:   MockDAAddress,
+			DANamespace: MockDANamespace,
+			P2P: config.P2PConfig{
+				ListenAddress: "/ip4/127.0.0.1/tcp/10001",
+				Seeds:         "/ip4/127.0.0.1/tcp/10000/p2p/" + activeID.String(),
+			},
+			Aggregator:         true,
+			BlockManagerConfig: bmConfig,
+		},
+		keys[1],
+		keys[0],
+		proxy.NewLocalClientCreator(getMockApplication()),
+		active.GetGenesis(),
+		DefaultMetricsProvider(cmconfig.DefaultInstrumentationConfig()),
+		test.NewFileLoggerCustom(t, test.TempLogFileName(t, "standby")),
+	)
+	require.NoError(err)
+	standby := node.(*FullNode)
+	standby.dalc = dalc
+	standby.blockManager.SetDALC(dalc)
+	require.NoError(standby.SetLeaseBackend(lease))
+
+	require.NoError(active.Start())
+	require.NoError(waitForAtLeastNBlocks(active, 3, Store))
+	startNodeWithCleanup(t, standby)
+	require.NoError(waitForAtLeastNBlocks(standby, 3, Store))
+	activeHolder, _, _, err := active.p2pClient.Info()
+	require.NoError(err)
+	standbyHolder, _, _, err := standby.p2pClient.Info()
+	require.NoError(err)
+	current, err := lease.Load(ctx)
+	require.NoError(err)
+	require.EqualValues(activeHolder, current.Holder)
+
+	require.NoError(active.Stop())
+	activeHeight := active.Store.Height()
+
+	// standby takes over from the last height produced by the active aggregator
+	require.NoError(waitForAtLeastNBlocks(standby, int(activeHeight)+2, Store))
+	current, err = lease.Load(ctx)
+	require.NoError(err)
+	require.EqualValues(standbyHolder, current.Holder)
+
+	// chain produced by both aggregators is continuous
+	for h := uint64(2); h <= standby.Store.Height(); h++ {
+		prev, err := standby.Store.GetBlock(ctx, h-1)
+		require.NoError(err)
+		b, err := standby.Store.GetBlock(ctx, h)
+		require.NoError(err)
+		require.Equal(prev.Hash(), b.LastHeader(), "height %d", h)
+	}
+}
+
+func TestRemoteExecutor(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	executor := state.NewMockExecutor()
+	server := executorgrpc.NewServer(executor, grpc.Creds(insecure.NewCredentials()))
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+
+	key, _, _ := crypto.GenerateEd25519Key(rand.Reader)
+	genesisDoc, genesisValidatorKey := types.GetGenesisWithPrivkey()
+	signingKey, err := types.PrivKeyToSigningKey(genesisValidatorKey)
+	require.NoError(err)
+	// ABCI application is not used
+	app := &mocks.Application{}
+	node, err := newFullNode(ctx, config.NodeConfig{
+		DAAddress:          MockDAAddress,
+		DANamespace:        MockDANamespace,
+		Aggregator:         true,
+		BlockManagerConfig: getBMConfig(),
+		ExecutorAddress:    lis.Addr().String(),
+	}, key, signingKey, proxy.NewLocalClientCreator(app), genesisDoc, DefaultMetricsProvider(cmconfig.DefaultInstrumentationConfig()), test.NewFileLogger(t))
+	require.NoError(err)
+
+	executor.InjectTx([]byte("tx1"))
+	executor.InjectTx([]byte("tx2"))
+	startNodeWithCleanup(t, node)
+	require.NoError(waitForAtLeastNBlocks(node, 3, Store))
+
+	b, err := node.Store.GetBlock(ctx, 1)
+	require.NoError(err)
+	require.Equal(types.Txs{[]byte("tx1"), []byte("tx2")}, b.Data.Txs)
+	require.Equal([][]byte{[]byte("tx1"), []byte("tx2")}, executor.ExecutedTxs())
+
+	// blocks submitted to DA are marked as final
+	require.NoError(testutils.Retry(300, 100*time.Millisecond, func() error {
+		if executor.FinalHeight() == 0 {
+			return errors.New("no final blocks")
+		}
+		return nil
+	}))
+	app.AssertNotCalled(t, "FinalizeBlock", mock.Anything, mock.Anything)
+
+	// transactions are not accepted by the node, mempool is not used with remote executor
+	_, err = node.GetClient().BroadcastTxSync(ctx, []byte("tx3"))
+	require.ErrorIs(err, ErrRemoteExecutor)
+	require.Zero(node.Mempool.Size())
+}
+
 func testSingleAggregatorSingleFullNode(t *testing.T, source Source) {
 	require := require.New(t)
 
@@ -11,12 +11,15 @@ import (
 	"testing"
 	"time"
 
+	abcicli "github.com/cometbft/cometbft/abci/client"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmconfig "github.com/cometbft/cometbft/config"
 	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/proxy"
 	cmtypes "github.com/cometbft/cometbft/types"
 
+	"github.com/go-kit/kit/metrics/generic"
+	ds "github.com/ipfs/go-datastore"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -31,6 +34,9 @@ import (
 	"github.com/rollkit/rollkit/config"
 	"github.com/rollkit/rollkit/da"
 	"github.com/rollkit/rollkit/mempool"
+	"github.com/rollkit/rollkit/p2p"
+	"github.com/rollkit/rollkit/state"
+	"github.com/rollkit/rollkit/store"
 	test "github.com/rollkit/rollkit/test/log"
 	"github.com/rollkit/rollkit/test/mocks"
 	"github.com/rollkit/rollkit/types"
@@ -55,6 +61,27 @@ func TestMempoolDirectly(t *testing.T) {
 	verifyMempoolSize(node, t)
 }
 
+// Tests that transactions rejected by the mempool without calling CheckTx callback are rejected by the tx validator
+func TestTxValidatorRejectsWithoutCheckTx(t *testing.T) {
+	ctx := context.Background()
+	n, _ := setupTestNode(ctx, t, Full)
+	fn := n.(*FullNode)
+	fn.SetKeyProvider(state.NewMockKeyProvider([]byte("seed")))
+	startNodeWithCleanup(t, fn)
+
+	validate := fn.newTxValidator(p2p.NopMetrics())
+	done := make(chan bool)
+	go func() {
+		done <- validate(&p2p.GossipMessage{Data: append(types.DecryptionKeysPrefix, 1), From: getPeerID(t)})
+	}()
+	select {
+	case valid := <-done:
+		assert.False(t, valid)
+	case <-time.After(5 * time.Second):
+		t.Fatal("tx validator didn't return")
+	}
+}
+
 // Tests that the node is able to sync multiple blocks even if blocks arrive out of order
 func TestTrySyncNextBlockMultiple(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -320,6 +347,126 @@ func TestVoteExtension(t *testing.T) {
 	app.AssertExpectations(t)
 }
 
+func TestRPCOnly(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	mockDA := new(mocks.DA)
+	mockDA.On("MaxBlobSize", mock.Anything).Return(uint64(10240), nil)
+	mockDA.On("Submit", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("DA not available"))
+	dac := da.NewDAClient(mockDA, 1234, -1, goDA.Namespace(MockDANamespace), nil)
+	dbPath := t.TempDir()
+
+	node, _ := createAggregatorWithPersistence(ctx, dbPath, dac, t)
+	require.NoError(node.Start())
+	require.NoError(waitForAtLeastNBlocks(node, 3, Store))
+	require.NoError(node.Stop())
+	height := node.(*FullNode).Store.Height()
+
+	key, _, _ := crypto.GenerateEd25519Key(rand.Reader)
+	genesis, genesisValidatorKey := types.GetGenesisWithPrivkey()
+	genesis.InitialHeight = 1
+	signingKey, err := types.PrivKeyToSigningKey(genesisValidatorKey)
+	require.NoError(err)
+	rpcNode, err := NewNode(
+		ctx,
+		config.NodeConfig{
+			DBPath:         dbPath,
+			DAAddress:      MockDAAddress,
+			DANamespace:    MockDANamespace,
+			RPCOnly:        true,
+			StoreCacheSize: 10,
+			BlockManagerConfig: config.BlockManagerConfig{
+				BlockTime: 100 * time.Millisecond,
+			},
+		},
+		key,
+		signingKey,
+		unusedClientCreator{},
+		genesis,
+		DefaultMetricsProvider(cmconfig.DefaultInstrumentationConfig()),
+		test.NewFileLoggerCustom(t, test.TempLogFileName(t, "")),
+	)
+	require.NoError(err)
+	fullNode := rpcNode.(*FullNode)
+	require.NoError(rpcNode.Start())
+	defer func() {
+		assert.NoError(rpcNode.Stop())
+	}()
+
+	// nothing is synced, so none of the components syncing the chain is created
+	assert.Nil(fullNode.proxyApp)
+	assert.Nil(fullNode.executor)
+	assert.Nil(fullNode.dalc)
+	assert.Nil(fullNode.p2pClient)
+	assert.Nil(fullNode.hSyncService)
+	assert.Nil(fullNode.bSyncService)
+	assert.Nil(fullNode.blockManager)
+
+	client := rpcNode.GetClient()
+	status, err := client.Status(ctx)
+	require.NoError(err)
+	assert.EqualValues(height, status.SyncInfo.LatestBlockHeight)
+	h := int64(2)
+	block, err := client.Block(ctx, &h)
+	require.NoError(err)
+	assert.EqualValues(2, block.Block.Height)
+	_, err = client.Health(ctx)
+	assert.NoError(err)
+
+	id, err := p2p.NodeID(key)
+	require.NoError(err)
+	assert.Equal(id, status.NodeInfo.DefaultNodeID)
+
+	_, err = client.BroadcastTxSync(ctx, []byte("tx"))
+	assert.ErrorIs(err, ErrRPCOnly)
+	_, err = client.ABCIInfo(ctx)
+	assert.ErrorIs(err, ErrRPCOnly)
+	assert.ErrorIs(fullNode.Store.SetMetadata(ctx, "key", []byte("value")), store.ErrReadOnly)
+
+	require.NoError(fullNode.refresh(ctx))
+	assert.Equal(height, fullNode.Store.Height())
+	block, err = client.Block(ctx, &h)
+	require.NoError(err)
+	assert.EqualValues(2, block.Block.Height)
+}
+
+// unusedClientCreator fails to create ABCI clients, for nodes that must not connect to the application.
+type unusedClientCreator struct{}
+
+func (unusedClientCreator) NewABCIClient() (abcicli.Client, error) {
+	return nil, errors.New("ABCI client must not be created")
+}
+
+func TestStoreMaintenance(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	assert.Error(validateStoreMaintenance(config.NodeConfig{DBGCInterval: time.Minute, DBGCDiscardRatio: 1}))
+	assert.NoError(validateStoreMaintenance(config.NodeConfig{DBGCInterval: 0, DBGCDiscardRatio: 1}))
+	assert.NoError(validateStoreMaintenance(config.DefaultNodeConfig))
+
+	kv, err := store.NewKVStore(store.LevelDBBackend, t.TempDir(), "data", "test")
+	require.NoError(err)
+	defer kv.Close() //nolint:errcheck
+	for i := 0; i < 10; i++ {
+		require.NoError(kv.Put(ctx, ds.NewKey(fmt.Sprintf("/b/%d", i)), make([]byte, 100)))
+	}
+
+	metrics := store.NopMetrics()
+	gcRuns := generic.NewCounter("gc_runs")
+	diskUsage := generic.NewGauge("disk_usage_bytes")
+	metrics.GCRuns = gcRuns
+	metrics.DiskUsage = diskUsage
+	nodeConfig := config.DefaultNodeConfig
+	nodeConfig.DBDiskUsageAlert = 1
+	maintainStore(ctx, kv, nodeConfig, metrics, test.NewFileLoggerCustom(t, test.TempLogFileName(t, "")))
+	assert.Equal(1.0, gcRuns.Value())
+	assert.Greater(diskUsage.Value(), 0.0)
+}
+
 func createAggregatorWithPersistence(ctx context.Context, dbPath string, dalc *da.DAClient, t *testing.T) (Node, *mocks.Application) {
 	t.Helper()
 
@@ -33,6 +33,10 @@ type LightNode struct {
 
 	client rpcclient.Client
 
+	conf         config.NodeConfig
+	datastore    ds.TxnDatastore
+	storeMetrics *store.Metrics
+
 	ctx    context.Context
 	cancel context.CancelFunc
 }
@@ -61,7 +65,11 @@ func newLightNode(
 		}
 	}()
 
-	_, p2pMetrics, _, _, abciMetrics := metricsProvider(genesis.ChainID)
+	if err := validateStoreMaintenance(conf); err != nil {
+		return nil, err
+	}
+
+	_, p2pMetrics, _, _, abciMetrics, storeMetrics := metricsProvider(genesis.ChainID)
 
 	// Create the proxyApp and establish connections to the ABCI app (consensus, mempool, query).
 	proxyApp := proxy.NewAppConns(clientCreator, abciMetrics)
@@ -88,11 +96,15 @@ func newLightNode(
 		P2P:          client,
 		proxyApp:     proxyApp,
 		hSyncService: headerSyncService,
+		conf:         conf,
+		datastore:    datastore,
+		storeMetrics: storeMetrics,
 		cancel:       cancel,
 		ctx:          ctx,
 	}
 
 	node.P2P.SetTxValidator(node.falseValidator())
+	node.P2P.SetEvidenceValidator(node.falseValidator())
 
 	node.BaseService = *service.NewBaseService(logger, "LightNode", node)
 
@@ -106,7 +118,7 @@ func openDatastore(conf config.NodeConfig, logger log.Logger) (ds.TxnDatastore,
 		logger.Info("WARNING: working in in-memory mode")
 		return store.NewDefaultInMemoryKVStore()
 	}
-	return store.NewDefaultKVStore(conf.RootDir, conf.DBPath, "rollkit-light")
+	return store.NewKVStore(conf.DBBackend, conf.RootDir, conf.DBPath, "rollkit-light")
 }
 
 // Cancel calls the underlying context's cancel function.
@@ -124,6 +136,10 @@ func (ln *LightNode) OnStart() error {
 		return fmt.Errorf("error while starting header sync service: %w", err)
 	}
 
+	if ln.conf.DBGCInterval > 0 {
+		go storeMaintenanceLoop(ln.ctx, ln.datastore, ln.conf, ln.storeMetrics, ln.Logger)
+	}
+
 	return nil
 }
 
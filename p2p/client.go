@@ -41,6 +41,9 @@ const (
 
 	// txTopicSuffix is added after namespace to create pubsub topic for TX gossiping.
 	txTopicSuffix = "-tx"
+
+	// evidenceTopicSuffix is added after namespace to create pubsub topic for evidence gossiping.
+	evidenceTopicSuffix = "-evidence"
 )
 
 // Client is a P2P client, implemented with libp2p.
@@ -62,6 +65,9 @@ type Client struct {
 	txGossiper  *Gossiper
 	txValidator GossipValidator
 
+	evidenceGossiper  *Gossiper
+	evidenceValidator GossipValidator
+
 	// cancel is used to cancel context passed to libp2p functions
 	// it's required because of discovery.Advertise call
 	cancel context.CancelFunc
@@ -156,6 +162,7 @@ func (c *Client) Close() error {
 
 	return errors.Join(
 		c.txGossiper.Close(),
+		c.evidenceGossiper.Close(),
 		c.dht.Close(),
 		c.host.Close(),
 	)
@@ -172,6 +179,17 @@ func (c *Client) SetTxValidator(val GossipValidator) {
 	c.txValidator = val
 }
 
+// GossipEvidence sends the evidence of misbehavior (protobuf encoded) to the P2P network.
+func (c *Client) GossipEvidence(ctx context.Context, evidence []byte) error {
+	c.logger.Debug("Gossiping evidence", "len", len(evidence))
+	return c.evidenceGossiper.Publish(ctx, evidence)
+}
+
+// SetEvidenceValidator sets the callback function, that will be invoked during evidence gossiping.
+func (c *Client) SetEvidenceValidator(val GossipValidator) {
+	c.evidenceValidator = val
+}
+
 // Addrs returns listen addresses of Client.
 func (c *Client) Addrs() []multiaddr.Multiaddr {
 	return c.host.Addrs()
@@ -194,11 +212,20 @@ func (c *Client) ConnectionGater() *conngater.BasicConnectionGater {
 
 // Info returns client ID, ListenAddr, and Network info
 func (c *Client) Info() (p2p.ID, string, string, error) {
-	rawKey, err := c.privKey.GetPublic().Raw()
+	id, err := NodeID(c.privKey)
 	if err != nil {
 		return "", "", "", err
 	}
-	return p2p.ID(hex.EncodeToString(tmcrypto.AddressHash(rawKey))), c.conf.ListenAddress, c.chainID, nil
+	return id, c.conf.ListenAddress, c.chainID, nil
+}
+
+// NodeID returns ID of the node using given P2P private key, as returned by Info.
+func NodeID(privKey crypto.PrivKey) (p2p.ID, error) {
+	rawKey, err := privKey.GetPublic().Raw()
+	if err != nil {
+		return "", err
+	}
+	return p2p.ID(hex.EncodeToString(tmcrypto.AddressHash(rawKey))), nil
 }
 
 // PeerConnection describe basic information about P2P connection.
@@ -365,6 +392,12 @@ func (c *Client) setupGossiping(ctx context.Context) error {
 	}
 	go c.txGossiper.ProcessMessages(ctx)
 
+	c.evidenceGossiper, err = NewGossiper(c.host, c.ps, c.getEvidenceTopic(), c.logger, WithValidator(c.evidenceValidator))
+	if err != nil {
+		return err
+	}
+	go c.evidenceGossiper.ProcessMessages(ctx)
+
 	return nil
 }
 
@@ -402,3 +435,7 @@ func (c *Client) getNamespace() string {
 func (c *Client) getTxTopic() string {
 	return c.getNamespace() + txTopicSuffix
 }
+
+func (c *Client) getEvidenceTopic() string {
+	return c.getNamespace() + evidenceTopicSuffix
+}
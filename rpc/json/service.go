@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -13,10 +14,15 @@ import (
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmtypes "github.com/cometbft/cometbft/types"
 	"github.com/gorilla/rpc/v2"
 	"github.com/gorilla/rpc/v2/json2"
 
+	"github.com/rollkit/rollkit/block"
+	"github.com/rollkit/rollkit/evidence"
+	"github.com/rollkit/rollkit/mempool"
 	"github.com/rollkit/rollkit/third_party/log"
+	rolltypes "github.com/rollkit/rollkit/types"
 )
 
 // GetHTTPHandler returns handler configured to serve Tendermint-compatible RPC.
@@ -42,6 +48,46 @@ func newMethod(m interface{}) *method {
 	}
 }
 
+// haltReporter is implemented by clients of nodes that can halt after failing to apply a block.
+type haltReporter interface {
+	HaltReport() *block.HaltReport
+	LastHaltReport(ctx context.Context) (*block.HaltReport, error)
+}
+
+// livenessReporter is implemented by clients of nodes monitoring the sequencer.
+type livenessReporter interface {
+	SequencerLiveness() *block.SequencerLiveness
+}
+
+// daBacklogReporter is implemented by clients of nodes submitting blocks to DA layer.
+type daBacklogReporter interface {
+	DABacklog() *block.DABacklog
+}
+
+// txStatusReporter is implemented by clients of nodes tracking lifecycle of transactions.
+type txStatusReporter interface {
+	TxStatus(ctx context.Context, hash []byte) (*mempool.TxStatus, error)
+}
+
+// preConfirmer is implemented by clients of nodes issuing and verifying sequencer pre-confirmations.
+type preConfirmer interface {
+	BroadcastTxAsyncWithPreConfirmation(ctx context.Context, tx cmtypes.Tx) (*ctypes.ResultBroadcastTx, *rolltypes.PreConfirmation, error)
+	BroadcastTxSyncWithPreConfirmation(ctx context.Context, tx cmtypes.Tx) (*ctypes.ResultBroadcastTx, *rolltypes.PreConfirmation, error)
+	BroadcastTxCommitWithPreConfirmation(ctx context.Context, tx cmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, *rolltypes.PreConfirmation, error)
+	AddPreConfirmation(ctx context.Context, preConfirmation *rolltypes.PreConfirmation) error
+	BrokenPreConfirmation(ctx context.Context, txHash []byte) (*block.BrokenPreConfirmation, error)
+}
+
+// evidenceReporter is implemented by clients of nodes keeping evidence of misbehavior.
+type evidenceReporter interface {
+	Evidence(ctx context.Context, hash []byte) (*evidence.Info, error)
+}
+
+// blockByTimeReporter is implemented by clients of nodes indexing blocks by time.
+type blockByTimeReporter interface {
+	BlockByTime(ctx context.Context, t time.Time) (*ctypes.ResultBlock, error)
+}
+
 type service struct {
 	client  rpcclient.Client
 	methods map[string]*method
@@ -65,12 +111,14 @@ func newService(c rpcclient.Client, l log.Logger) *service {
 		"genesis_chunked":      newMethod(s.GenesisChunked),
 		"block":                newMethod(s.Block),
 		"block_by_hash":        newMethod(s.BlockByHash),
+		"block_by_time":        newMethod(s.BlockByTime),
 		"block_results":        newMethod(s.BlockResults),
 		"commit":               newMethod(s.Commit),
 		"header":               newMethod(s.Header),
 		"header_by_hash":       newMethod(s.HeaderByHash),
 		"check_tx":             newMethod(s.CheckTx),
 		"tx":                   newMethod(s.Tx),
+		"tx_status":            newMethod(s.TxStatus),
 		"tx_search":            newMethod(s.TxSearch),
 		"block_search":         newMethod(s.BlockSearch),
 		"validators":           newMethod(s.Validators),
@@ -85,6 +133,11 @@ func newService(c rpcclient.Client, l log.Logger) *service {
 		"abci_query":           newMethod(s.ABCIQuery),
 		"abci_info":            newMethod(s.ABCIInfo),
 		"broadcast_evidence":   newMethod(s.BroadcastEvidence),
+		"evidence":             newMethod(s.Evidence),
+		"halt_report":          newMethod(s.HaltReport),
+
+		"submit_preconfirmation": newMethod(s.SubmitPreConfirmation),
+		"broken_preconfirmation": newMethod(s.BrokenPreConfirmation),
 	}
 	return &s
 }
@@ -158,8 +211,47 @@ func (s *service) Health(req *http.Request, args *healthArgs) (*ctypes.ResultHea
 	return s.client.Health(req.Context())
 }
 
-func (s *service) Status(req *http.Request, args *statusArgs) (*ctypes.ResultStatus, error) {
-	return s.client.Status(req.Context())
+func (s *service) Status(req *http.Request, args *statusArgs) (*resultStatus, error) {
+	res, err := s.client.Status(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	status := &resultStatus{
+		NodeInfo:      res.NodeInfo,
+		SyncInfo:      res.SyncInfo,
+		ValidatorInfo: res.ValidatorInfo,
+	}
+	if hr, ok := s.client.(haltReporter); ok {
+		if report := hr.HaltReport(); report != nil {
+			status.Halted = &haltStatus{
+				Height: report.Height,
+				Error:  report.Error,
+				Time:   report.Time,
+			}
+		}
+	}
+	if lr, ok := s.client.(livenessReporter); ok {
+		status.Sequencer = lr.SequencerLiveness()
+	}
+	if br, ok := s.client.(daBacklogReporter); ok {
+		status.DABacklog = br.DABacklog()
+	}
+	return status, nil
+}
+
+func (s *service) HaltReport(req *http.Request, args *haltReportArgs) (*resultHaltReport, error) {
+	hr, ok := s.client.(haltReporter)
+	if !ok {
+		return nil, errors.New("halt reports are not supported")
+	}
+	report, err := hr.LastHaltReport(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	return &resultHaltReport{
+		Halted: hr.HaltReport() != nil,
+		Report: report,
+	}, nil
 }
 
 func (s *service) NetInfo(req *http.Request, args *netInfoArgs) (*ctypes.ResultNetInfo, error) {
@@ -186,6 +278,18 @@ func (s *service) BlockByHash(req *http.Request, args *blockByHashArgs) (*ctypes
 	return s.client.BlockByHash(req.Context(), args.Hash)
 }
 
+func (s *service) BlockByTime(req *http.Request, args *blockByTimeArgs) (*ctypes.ResultBlock, error) {
+	br, ok := s.client.(blockByTimeReporter)
+	if !ok {
+		return nil, errors.New("block lookup by time is not supported")
+	}
+	t, err := time.Parse(time.RFC3339Nano, args.Time)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time: %w", err)
+	}
+	return br.BlockByTime(req.Context(), t)
+}
+
 func (s *service) BlockResults(req *http.Request, args *blockResultsArgs) (*ctypes.ResultBlockResults, error) {
 	return s.client.BlockResults(req.Context(), (*int64)(&args.Height))
 }
@@ -210,6 +314,14 @@ func (s *service) Tx(req *http.Request, args *txArgs) (*ctypes.ResultTx, error)
 	return s.client.Tx(req.Context(), args.Hash, args.Prove)
 }
 
+func (s *service) TxStatus(req *http.Request, args *txStatusArgs) (*mempool.TxStatus, error) {
+	tr, ok := s.client.(txStatusReporter)
+	if !ok {
+		return nil, errors.New("tx status tracking is not supported")
+	}
+	return tr.TxStatus(req.Context(), args.Hash)
+}
+
 func (s *service) TxSearch(req *http.Request, args *txSearchArgs) (*ctypes.ResultTxSearch, error) {
 	return s.client.TxSearch(req.Context(), args.Query, args.Prove, (*int)(&args.Page), (*int)(&args.PerPage), args.OrderBy)
 }
@@ -243,16 +355,95 @@ func (s *service) NumUnconfirmedTxs(req *http.Request, args *numUnconfirmedTxsAr
 }
 
 // tx broadcast API
-func (s *service) BroadcastTxCommit(req *http.Request, args *broadcastTxCommitArgs) (*ctypes.ResultBroadcastTxCommit, error) {
-	return s.client.BroadcastTxCommit(req.Context(), args.Tx)
-}
-
-func (s *service) BroadcastTxSync(req *http.Request, args *broadcastTxSyncArgs) (*ctypes.ResultBroadcastTx, error) {
-	return s.client.BroadcastTxSync(req.Context(), args.Tx)
+func (s *service) BroadcastTxCommit(req *http.Request, args *broadcastTxCommitArgs) (*resultBroadcastTxCommit, error) {
+	var (
+		res             *ctypes.ResultBroadcastTxCommit
+		preConfirmation *rolltypes.PreConfirmation
+		err             error
+	)
+	if pc, ok := s.client.(preConfirmer); ok {
+		res, preConfirmation, err = pc.BroadcastTxCommitWithPreConfirmation(req.Context(), args.Tx)
+	} else {
+		res, err = s.client.BroadcastTxCommit(req.Context(), args.Tx)
+	}
+	if res == nil {
+		return nil, err
+	}
+	return &resultBroadcastTxCommit{
+		CheckTx:         res.CheckTx,
+		TxResult:        res.TxResult,
+		Hash:            res.Hash,
+		Height:          res.Height,
+		PreConfirmation: preConfirmation,
+	}, err
+}
+
+func (s *service) BroadcastTxSync(req *http.Request, args *broadcastTxSyncArgs) (*resultBroadcastTx, error) {
+	var (
+		res             *ctypes.ResultBroadcastTx
+		preConfirmation *rolltypes.PreConfirmation
+		err             error
+	)
+	if pc, ok := s.client.(preConfirmer); ok {
+		res, preConfirmation, err = pc.BroadcastTxSyncWithPreConfirmation(req.Context(), args.Tx)
+	} else {
+		res, err = s.client.BroadcastTxSync(req.Context(), args.Tx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &resultBroadcastTx{
+		Code:            res.Code,
+		Data:            res.Data,
+		Log:             res.Log,
+		Codespace:       res.Codespace,
+		Hash:            res.Hash,
+		PreConfirmation: preConfirmation,
+	}, nil
+}
+
+func (s *service) BroadcastTxAsync(req *http.Request, args *broadcastTxAsyncArgs) (*resultBroadcastTx, error) {
+	var (
+		res             *ctypes.ResultBroadcastTx
+		preConfirmation *rolltypes.PreConfirmation
+		err             error
+	)
+	if pc, ok := s.client.(preConfirmer); ok {
+		res, preConfirmation, err = pc.BroadcastTxAsyncWithPreConfirmation(req.Context(), args.Tx)
+	} else {
+		res, err = s.client.BroadcastTxAsync(req.Context(), args.Tx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &resultBroadcastTx{
+		Code:            res.Code,
+		Data:            res.Data,
+		Log:             res.Log,
+		Codespace:       res.Codespace,
+		Hash:            res.Hash,
+		PreConfirmation: preConfirmation,
+	}, nil
+}
+
+// pre-confirmations API
+func (s *service) SubmitPreConfirmation(req *http.Request, args *submitPreConfirmationArgs) (*resultSubmitPreConfirmation, error) {
+	pc, ok := s.client.(preConfirmer)
+	if !ok {
+		return nil, errors.New("pre-confirmations are not supported")
+	}
+	if err := pc.AddPreConfirmation(req.Context(), &args.PreConfirmation); err != nil {
+		return nil, err
+	}
+	return &resultSubmitPreConfirmation{TxHash: args.PreConfirmation.TxHash}, nil
 }
 
-func (s *service) BroadcastTxAsync(req *http.Request, args *broadcastTxAsyncArgs) (*ctypes.ResultBroadcastTx, error) {
-	return s.client.BroadcastTxAsync(req.Context(), args.Tx)
+func (s *service) BrokenPreConfirmation(req *http.Request, args *brokenPreConfirmationArgs) (*block.BrokenPreConfirmation, error) {
+	pc, ok := s.client.(preConfirmer)
+	if !ok {
+		return nil, errors.New("pre-confirmations are not supported")
+	}
+	return pc.BrokenPreConfirmation(req.Context(), args.Hash)
 }
 
 // abci API
@@ -271,3 +462,11 @@ func (s *service) ABCIInfo(req *http.Request, args *ABCIInfoArgs) (*ctypes.Resul
 func (s *service) BroadcastEvidence(req *http.Request, args *broadcastEvidenceArgs) (*ctypes.ResultBroadcastEvidence, error) {
 	return s.client.BroadcastEvidence(req.Context(), args.Evidence)
 }
+
+func (s *service) Evidence(req *http.Request, args *evidenceArgs) (*evidence.Info, error) {
+	er, ok := s.client.(evidenceReporter)
+	if !ok {
+		return nil, errors.New("evidence pool is not supported")
+	}
+	return er.Evidence(req.Context(), args.Hash)
+}
@@ -78,6 +78,9 @@ func TestREST(t *testing.T) {
 			http.StatusOK, int(json2.E_PARSE), "failed to parse param 'prove'"},
 		{"valid/hex param", "/check_tx?tx=DEADBEEF", http.StatusOK, -1, `"gas_used":"1000"`},
 		{"invalid/hex param", "/check_tx?tx=QWERTY", http.StatusOK, int(json2.E_PARSE), "failed to parse param 'tx'"},
+		{"valid/time param", "/block_by_time?time=2020-01-01T00:00:00Z", http.StatusOK, int(json2.E_INTERNAL), "failed to find block at or before"},
+		{"invalid/time param", "/block_by_time?time=yesterday", http.StatusOK, int(json2.E_INTERNAL), "invalid time"},
+		{"valid/not halted", "/halt_report", http.StatusOK, -1, `"halted":false`},
 	}
 
 	_, local := getRPC(t)
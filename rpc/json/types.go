@@ -4,10 +4,17 @@ import (
 	"encoding/json"
 	"reflect"
 	"strconv"
+	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/p2p"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
 	"github.com/gorilla/rpc/v2/json2"
+
+	"github.com/rollkit/rollkit/block"
+	rolltypes "github.com/rollkit/rollkit/types"
 )
 
 type subscribeArgs struct {
@@ -41,6 +48,10 @@ type blockArgs struct {
 type blockByHashArgs struct {
 	Hash []byte `json:"hash"`
 }
+type blockByTimeArgs struct {
+	// Time in RFC 3339 format.
+	Time string `json:"time"`
+}
 type blockResultsArgs struct {
 	Height StrInt64 `json:"height"`
 }
@@ -60,6 +71,9 @@ type txArgs struct {
 	Hash  []byte `json:"hash"`
 	Prove bool   `json:"prove"`
 }
+type txStatusArgs struct {
+	Hash []byte `json:"hash"`
+}
 type txSearchArgs struct {
 	Query   string `json:"query"`
 	Prove   bool   `json:"prove"`
@@ -121,9 +135,68 @@ type ABCIInfoArgs struct {
 type broadcastEvidenceArgs struct {
 	Evidence types.Evidence `json:"evidence"`
 }
+type submitPreConfirmationArgs struct {
+	PreConfirmation rolltypes.PreConfirmation `json:"preconfirmation"`
+}
+type evidenceArgs struct {
+	Hash []byte `json:"hash"`
+}
+
+type brokenPreConfirmationArgs struct {
+	Hash []byte `json:"hash"`
+}
+type haltReportArgs struct {
+}
 
 type emptyResult struct{}
 
+// resultStatus extends Tendermint status with Rollkit specific information.
+type resultStatus struct {
+	NodeInfo      p2p.DefaultNodeInfo      `json:"node_info"`
+	SyncInfo      ctypes.SyncInfo          `json:"sync_info"`
+	ValidatorInfo ctypes.ValidatorInfo     `json:"validator_info"`
+	Halted        *haltStatus              `json:"halted,omitempty"`
+	Sequencer     *block.SequencerLiveness `json:"sequencer,omitempty"`
+	DABacklog     *block.DABacklog         `json:"da_backlog,omitempty"`
+}
+
+// haltStatus summarizes the crash report of a halted node, full report is served by halt_report method.
+type haltStatus struct {
+	Height uint64    `json:"height"`
+	Error  string    `json:"error"`
+	Time   time.Time `json:"time"`
+}
+
+// resultHaltReport contains the crash report of the current or last halt of the node.
+type resultHaltReport struct {
+	Halted bool              `json:"halted"`
+	Report *block.HaltReport `json:"report,omitempty"`
+}
+
+// resultBroadcastTx extends ctypes.ResultBroadcastTx with sequencer pre-confirmation.
+type resultBroadcastTx struct {
+	Code            uint32                     `json:"code"`
+	Data            bytes.HexBytes             `json:"data"`
+	Log             string                     `json:"log"`
+	Codespace       string                     `json:"codespace"`
+	Hash            bytes.HexBytes             `json:"hash"`
+	PreConfirmation *rolltypes.PreConfirmation `json:"preconfirmation,omitempty"`
+}
+
+// resultBroadcastTxCommit extends ctypes.ResultBroadcastTxCommit with sequencer pre-confirmation.
+type resultBroadcastTxCommit struct {
+	CheckTx         abci.ResponseCheckTx       `json:"check_tx"`
+	TxResult        abci.ExecTxResult          `json:"tx_result"`
+	Hash            bytes.HexBytes             `json:"hash"`
+	Height          int64                      `json:"height"`
+	PreConfirmation *rolltypes.PreConfirmation `json:"preconfirmation,omitempty"`
+}
+
+// resultSubmitPreConfirmation is returned after pre-confirmation was verified and accepted for tracking.
+type resultSubmitPreConfirmation struct {
+	TxHash bytes.HexBytes `json:"tx_hash"`
+}
+
 // JSON-deserialization specific types
 
 // StrInt is an proper int or quoted "int"
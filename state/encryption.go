@@ -0,0 +1,250 @@
+package state
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+const (
+	// CodeTypeDecryptionKeys is the result code of transaction revealing decryption keys.
+	CodeTypeDecryptionKeys uint32 = abci.CodeTypeOK
+	// CodeTypeDecryptionFailed is the result code of encrypted transaction that couldn't be decrypted.
+	CodeTypeDecryptionFailed uint32 = 1
+	// decryptionCodespace is the codespace of results of transactions not passed to the application.
+	decryptionCodespace = "rollkit/decryption"
+)
+
+var (
+	// ErrKeyNotRevealed is returned when decryption key wasn't revealed.
+	ErrKeyNotRevealed = errors.New("decryption key not revealed")
+
+	// ErrInvalidDecryptionKey is returned when revealed key doesn't match key ID.
+	ErrInvalidDecryptionKey = errors.New("invalid decryption key")
+)
+
+// KeyProvider releases decryption keys of encrypted transactions.
+//
+// Sequencer orders encrypted transactions without knowing their content. Keys are revealed only after sequencer
+// committed to the ordering, e.g. by a threshold committee of keypers, or after a time-lock round.
+type KeyProvider interface {
+	// RevealKey returns decryption key for given key ID. Commitment is the hash of ordered transactions of the block.
+	RevealKey(ctx context.Context, keyID uint64, commitment []byte) ([]byte, error)
+	// Decrypt decrypts ciphertext with revealed key. Error is returned if key is not valid for key ID.
+	Decrypt(keyID uint64, key, ciphertext []byte) ([]byte, error)
+}
+
+// SetKeyProvider enables encrypted transactions mode, where encrypted transactions are executed in the committed order
+// after their decryption keys are revealed.
+//
+// All nodes must use compatible key providers. It's supported only with ABCI application.
+func (e *BlockExecutor) SetKeyProvider(keyProvider KeyProvider) {
+	e.keyProvider = keyProvider
+}
+
+// splitEncryptedTxs separates encrypted transactions from plaintext transactions, preserving order.
+func splitEncryptedTxs(txs cmtypes.Txs) (encrypted cmtypes.Txs, plain cmtypes.Txs) {
+	for _, tx := range txs {
+		if types.IsEncryptedTx(tx) {
+			encrypted = append(encrypted, tx)
+		} else {
+			plain = append(plain, tx)
+		}
+	}
+	return encrypted, plain
+}
+
+// commitAndReveal commits to the ordering of transactions and appends the transaction revealing decryption keys.
+//
+// If keys can't be revealed, encrypted transactions are left in mempool, and only plaintext transactions are returned.
+func (e *BlockExecutor) commitAndReveal(ctx context.Context, encrypted, plain cmtypes.Txs) cmtypes.Txs {
+	txs := append(append(cmtypes.Txs{}, encrypted...), plain...)
+	commitment := txs.Hash()
+
+	keyIDs := make(map[uint64]struct{})
+	for _, tx := range encrypted {
+		var etx types.EncryptedTx
+		if err := etx.UnmarshalBinary(tx); err == nil {
+			keyIDs[etx.KeyID] = struct{}{}
+		}
+	}
+	keys := make(types.DecryptionKeys, 0, len(keyIDs))
+	for keyID := range keyIDs {
+		key, err := e.keyProvider.RevealKey(ctx, keyID, commitment)
+		if err != nil {
+			e.logger.Error("failed to reveal decryption key, skipping encrypted transactions", "keyID", keyID, "error", err)
+			return plain
+		}
+		keys = append(keys, types.DecryptionKey{KeyID: keyID, Key: key})
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].KeyID < keys[j].KeyID })
+
+	revealTx, err := keys.MarshalBinary()
+	if err != nil {
+		e.logger.Error("failed to encode decryption keys, skipping encrypted transactions", "error", err)
+		return plain
+	}
+	return append(txs, revealTx)
+}
+
+// appTxs returns transactions of the block passed to the application.
+//
+// In encrypted transactions mode, encrypted transactions are replaced with decrypted ones. Decryption keys are revealed
+// in the last transaction of the block. Transaction revealing keys and transactions that can't be decrypted are not
+// passed to the application; their results are returned instead, keyed by the index in the block.
+func (e *BlockExecutor) appTxs(block *types.Block) ([][]byte, map[int]*abci.ExecTxResult) {
+	if e.keyProvider == nil {
+		return block.Data.Txs.ToSliceOfBytes(), nil
+	}
+
+	blockTxs := block.Data.Txs
+	keys := make(map[uint64][]byte)
+	results := make(map[int]*abci.ExecTxResult)
+	if n := len(blockTxs); n > 0 && types.IsDecryptionKeysTx(blockTxs[n-1]) {
+		var revealed types.DecryptionKeys
+		if err := revealed.UnmarshalBinary(blockTxs[n-1]); err != nil {
+			results[n-1] = &abci.ExecTxResult{Code: CodeTypeDecryptionFailed, Codespace: decryptionCodespace, Log: err.Error()}
+		} else {
+			results[n-1] = &abci.ExecTxResult{Code: CodeTypeDecryptionKeys, Codespace: decryptionCodespace}
+		}
+		for _, key := range revealed {
+			keys[key.KeyID] = key.Key
+		}
+		blockTxs = blockTxs[:n-1]
+	}
+
+	txs := make([][]byte, 0, len(blockTxs))
+	for i, tx := range blockTxs {
+		if !types.IsEncryptedTx(tx) {
+			txs = append(txs, tx)
+			continue
+		}
+		plain, err := e.decrypt(tx, keys)
+		if err != nil {
+			results[i] = &abci.ExecTxResult{Code: CodeTypeDecryptionFailed, Codespace: decryptionCodespace, Log: err.Error()}
+			continue
+		}
+		txs = append(txs, plain)
+	}
+	return txs, results
+}
+
+func (e *BlockExecutor) decrypt(tx []byte, keys map[uint64][]byte) ([]byte, error) {
+	var etx types.EncryptedTx
+	if err := etx.UnmarshalBinary(tx); err != nil {
+		return nil, err
+	}
+	key, ok := keys[etx.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: key ID %d", ErrKeyNotRevealed, etx.KeyID)
+	}
+	return e.keyProvider.Decrypt(etx.KeyID, key, etx.Ciphertext)
+}
+
+// mergeTxResults merges results returned by the application with results of transactions not passed to the application.
+func mergeTxResults(n int, appResults []*abci.ExecTxResult, results map[int]*abci.ExecTxResult) []*abci.ExecTxResult {
+	if len(results) == 0 {
+		return appResults
+	}
+	merged := make([]*abci.ExecTxResult, 0, n)
+	for i := 0; i < n; i++ {
+		if res, ok := results[i]; ok {
+			merged = append(merged, res)
+			continue
+		}
+		merged = append(merged, appResults[0])
+		appResults = appResults[1:]
+	}
+	return merged
+}
+
+// MockKeyProvider is a local key provider intended for testing.
+//
+// Keys are derived from a seed and revealed on every request. Transactions are encrypted with AES-GCM.
+type MockKeyProvider struct {
+	seed []byte
+
+	mtx         sync.Mutex
+	commitments map[uint64][][]byte
+}
+
+var _ KeyProvider = &MockKeyProvider{}
+
+// NewMockKeyProvider creates a mock key provider deriving keys from seed.
+func NewMockKeyProvider(seed []byte) *MockKeyProvider {
+	return &MockKeyProvider{seed: seed, commitments: make(map[uint64][][]byte)}
+}
+
+// Key returns the key for key ID.
+func (p *MockKeyProvider) Key(keyID uint64) []byte {
+	h := sha256.New()
+	h.Write(p.seed)
+	_ = binary.Write(h, binary.BigEndian, keyID)
+	return h.Sum(nil)
+}
+
+// Encrypt encrypts transaction with the key for key ID, returning encrypted transaction ready to be submitted.
+func (p *MockKeyProvider) Encrypt(keyID uint64, tx []byte) ([]byte, error) {
+	aead, err := newAEAD(p.Key(keyID))
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	etx := types.EncryptedTx{KeyID: keyID, Ciphertext: aead.Seal(nonce, nonce, tx, nil)}
+	return etx.MarshalBinary()
+}
+
+// RevealKey returns the key for key ID, recording the commitment.
+func (p *MockKeyProvider) RevealKey(_ context.Context, keyID uint64, commitment []byte) ([]byte, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.commitments[keyID] = append(p.commitments[keyID], commitment)
+	return p.Key(keyID), nil
+}
+
+// Commitments returns commitments for which key with given ID was revealed.
+func (p *MockKeyProvider) Commitments(keyID uint64) [][]byte {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.commitments[keyID]
+}
+
+// Decrypt decrypts ciphertext created by Encrypt.
+func (p *MockKeyProvider) Decrypt(keyID uint64, key, ciphertext []byte) ([]byte, error) {
+	if !bytes.Equal(key, p.Key(keyID)) {
+		return nil, ErrInvalidDecryptionKey
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, types.ErrInvalidEncryptedTx
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,95 @@
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proxy"
+	cmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/rollkit/rollkit/mempool"
+	"github.com/rollkit/rollkit/test/mocks"
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestEncryptedTxs(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	var executed [][]byte
+	app := &mocks.Application{}
+	app.On("CheckTx", mock.Anything, mock.Anything).Return(&abci.ResponseCheckTx{}, nil)
+	app.On("PrepareProposal", mock.Anything, mock.Anything).Return(prepareProposalResponse)
+	app.On("FinalizeBlock", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+			executed = req.Txs
+			txResults := make([]*abci.ExecTxResult, len(req.Txs))
+			for idx := range req.Txs {
+				txResults[idx] = &abci.ExecTxResult{Code: abci.CodeTypeOK}
+			}
+			return &abci.ResponseFinalizeBlock{TxResults: txResults}, nil
+		},
+	)
+	client, err := proxy.NewLocalClientCreator(app).NewABCIClient()
+	require.NoError(err)
+
+	mpool := mempool.NewCListMempool(cfg.DefaultMempoolConfig(), proxy.NewAppConnMempool(client, proxy.NopMetrics()), 0)
+	mpool.EnableEncryptedTxs()
+	executor := NewABCIBlockExecutor([]byte("test address"), "test", mpool, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), nil, 1000, log.TestingLogger(), NopMetrics(), types.GetRandomBytes(32))
+	keyProvider := NewMockKeyProvider([]byte("seed"))
+	executor.SetKeyProvider(keyProvider)
+
+	state := types.State{}
+	state.ConsensusParams.Block = &cmproto.BlockParams{MaxBytes: 1000, MaxGas: 100000}
+
+	enc1, err := keyProvider.Encrypt(1, []byte("first"))
+	require.NoError(err)
+	enc2, err := keyProvider.Encrypt(2, []byte("second"))
+	require.NoError(err)
+	for _, tx := range [][]byte{enc1, []byte("plain"), enc2} {
+		require.NoError(mpool.CheckTx(tx, nil, mempool.TxInfo{}))
+	}
+	// encrypted transactions are not checked by the application
+	app.AssertNumberOfCalls(t, "CheckTx", 1)
+
+	keysTx, err := types.DecryptionKeys{{KeyID: 1, Key: keyProvider.Key(1)}}.MarshalBinary()
+	require.NoError(err)
+	require.ErrorIs(mpool.CheckTx(keysTx, nil, mempool.TxInfo{}), mempool.ErrDecryptionKeysTx)
+
+	block, err := executor.CreateBlock(1, &types.Commit{}, abci.ExtendedCommitInfo{}, []byte{}, state)
+	require.NoError(err)
+	require.Len(block.Data.Txs, 4)
+
+	// sequencer committed to the ordering of encrypted transactions before keys were revealed
+	committed := cmtypes.Txs{enc1, enc2, []byte("plain")}
+	assert.Equal(types.Tx(enc1), block.Data.Txs[0])
+	assert.Equal(types.Tx(enc2), block.Data.Txs[1])
+	assert.Equal([][]byte{committed.Hash()}, keyProvider.Commitments(1))
+	assert.True(types.IsDecryptionKeysTx(block.Data.Txs[3]))
+
+	block.SignedHeader.Validators = types.GetRandomValidatorSet()
+
+	resp, err := executor.execute(context.Background(), state, block)
+	require.NoError(err)
+	assert.Equal([][]byte{[]byte("first"), []byte("second"), []byte("plain")}, executed)
+	require.Len(resp.TxResults, 4)
+	assert.Equal(decryptionCodespace, resp.TxResults[3].Codespace)
+
+	// encrypted transaction without revealed key is not executed
+	block.Data.Txs = block.Data.Txs[:3]
+	resp, err = executor.execute(context.Background(), state, block)
+	require.NoError(err)
+	assert.Equal([][]byte{[]byte("plain")}, executed)
+	require.Len(resp.TxResults, 3)
+	assert.Equal(CodeTypeDecryptionFailed, resp.TxResults[0].Code)
+	assert.Equal(CodeTypeDecryptionFailed, resp.TxResults[1].Code)
+	assert.Equal(abci.CodeTypeOK, resp.TxResults[2].Code)
+}
@@ -0,0 +1,55 @@
+package state
+
+import (
+	"fmt"
+
+	cmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// EvidencePool keeps evidence of misbehavior until it's included in a block.
+type EvidencePool interface {
+	// PendingEvidence returns evidence to be included in the next block, up to maxBytes, and its size.
+	PendingEvidence(maxBytes int64) ([]cmtypes.Evidence, int64)
+	// CheckEvidence returns an error if evidence from the block was already committed, or is duplicated.
+	CheckEvidence(evidence []cmtypes.Evidence) error
+	// Update marks evidence included in the last block of the state as committed, and removes expired evidence.
+	Update(state types.State, evidence []cmtypes.Evidence) error
+}
+
+// SetEvidencePool enables inclusion of evidence in created blocks, and tracking of committed evidence.
+//
+// Evidence from blocks is verified and passed to ABCI application as Misbehavior even without evidence pool.
+func (e *BlockExecutor) SetEvidencePool(evpool EvidencePool) {
+	e.evpool = evpool
+}
+
+// pendingEvidence returns evidence to be included in the block, and its size.
+func (e *BlockExecutor) pendingEvidence(state types.State) ([]cmtypes.Evidence, int64) {
+	if e.evpool == nil {
+		return nil, 0
+	}
+	return e.evpool.PendingEvidence(state.ConsensusParams.GetEvidence().GetMaxBytes())
+}
+
+// validateEvidence verifies evidence from the block against the validator set of the block (the sequencer), and checks
+// that it didn't expire at the last block of the state.
+func (e *BlockExecutor) validateEvidence(state types.State, block *types.Block) error {
+	evidence := block.Data.Evidence.Evidence
+	if len(evidence) == 0 {
+		return nil
+	}
+	for _, ev := range evidence {
+		if err := types.VerifyEvidence(ev, e.chainID, block.SignedHeader.Validators); err != nil {
+			return fmt.Errorf("invalid evidence %X: %w", ev.Hash(), err)
+		}
+		if types.IsEvidenceExpired(ev, state.LastBlockHeight, state.LastBlockTime, state.ConsensusParams.GetEvidence()) {
+			return fmt.Errorf("%w: %X", types.ErrEvidenceExpired, ev.Hash())
+		}
+	}
+	if e.evpool != nil {
+		return e.evpool.CheckEvidence(evidence)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package state
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proxy"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/mempool"
+	"github.com/rollkit/rollkit/test/mocks"
+	"github.com/rollkit/rollkit/types"
+)
+
+type testEvidencePool struct {
+	pending   []cmtypes.Evidence
+	committed []cmtypes.Evidence
+}
+
+func (p *testEvidencePool) PendingEvidence(maxBytes int64) ([]cmtypes.Evidence, int64) {
+	if maxBytes <= 0 {
+		return nil, 0
+	}
+	return p.pending, 100
+}
+
+func (p *testEvidencePool) CheckEvidence(evidence []cmtypes.Evidence) error {
+	for _, ev := range evidence {
+		for _, committed := range p.committed {
+			if bytes.Equal(ev.Hash(), committed.Hash()) {
+				return errors.New("already committed")
+			}
+		}
+	}
+	return nil
+}
+
+func (p *testEvidencePool) Update(_ types.State, evidence []cmtypes.Evidence) error {
+	p.committed = append(p.committed, evidence...)
+	return nil
+}
+
+func TestEvidence(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	privKey := ed25519.GenPrivKey()
+	first, err := types.GetRandomSignedHeaderCustom(&types.HeaderConfig{Height: 1, DataHash: types.GetRandomBytes(32), PrivKey: privKey})
+	require.NoError(err)
+	second, err := types.GetRandomSignedHeaderCustom(&types.HeaderConfig{Height: 1, DataHash: types.GetRandomBytes(32), PrivKey: privKey})
+	require.NoError(err)
+	ev, err := types.NewDuplicateVoteEvidence(first, second)
+	require.NoError(err)
+
+	app := &mocks.Application{}
+	app.On("PrepareProposal", mock.Anything, mock.Anything).Return(prepareProposalResponse)
+	client, err := proxy.NewLocalClientCreator(app).NewABCIClient()
+	require.NoError(err)
+	mpool := mempool.NewCListMempool(cfg.DefaultMempoolConfig(), proxy.NewAppConnMempool(client, proxy.NopMetrics()), 0)
+	executor := NewABCIBlockExecutor([]byte("test address"), types.TestChainID, mpool, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), nil, 1000, log.TestingLogger(), NopMetrics(), types.GetRandomBytes(32))
+	evpool := &testEvidencePool{pending: []cmtypes.Evidence{ev}}
+	executor.SetEvidencePool(evpool)
+
+	state := types.State{}
+	state.ConsensusParams.Block = &cmproto.BlockParams{MaxBytes: 1000, MaxGas: 100000}
+
+	// evidence is not included if evidence params are not set
+	block, err := executor.CreateBlock(2, &types.Commit{}, abci.ExtendedCommitInfo{}, []byte{}, state)
+	require.NoError(err)
+	assert.Empty(block.Data.Evidence.Evidence)
+
+	state.ConsensusParams.Evidence = &cmproto.EvidenceParams{MaxBytes: 1000}
+	block, err = executor.CreateBlock(2, &types.Commit{}, abci.ExtendedCommitInfo{}, []byte{}, state)
+	require.NoError(err)
+	require.Len(block.Data.Evidence.Evidence, 1)
+	assert.Equal(ev.Hash(), block.Data.Evidence.Evidence[0].Hash())
+	assert.Len(block.Data.Evidence.ToABCI(), 1)
+
+	block.SignedHeader.Validators = first.Validators
+	assert.NoError(executor.validateEvidence(state, block))
+
+	// evidence against other sequencer is rejected
+	block.SignedHeader.Validators = types.GetRandomValidatorSet()
+	assert.ErrorIs(executor.validateEvidence(state, block), types.ErrInvalidEvidence)
+
+	// expired evidence is rejected
+	block.SignedHeader.Validators = first.Validators
+	expiredState := state
+	expiredState.LastBlockHeight = 100
+	expiredState.LastBlockTime = ev.Time().Add(2 * time.Hour)
+	expiredState.ConsensusParams.Evidence = &cmproto.EvidenceParams{MaxBytes: 1000, MaxAgeNumBlocks: 10, MaxAgeDuration: time.Hour}
+	assert.ErrorIs(executor.validateEvidence(expiredState, block), types.ErrEvidenceExpired)
+	expiredState.LastBlockTime = ev.Time().Add(time.Minute)
+	assert.NoError(executor.validateEvidence(expiredState, block))
+
+	// committed evidence is rejected
+	require.NoError(evpool.Update(types.State{LastBlockHeight: 2}, block.Data.Evidence.Evidence))
+	assert.Error(executor.validateEvidence(state, block))
+}
@@ -0,0 +1,194 @@
+package state
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proxy"
+	cmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/rollkit/rollkit/mempool"
+)
+
+// Executor is the interface between rollkit and the state machine of the rollup.
+//
+// Rollkit orders transactions into blocks, and the executor executes them and maintains the state. State machines
+// (e.g. EVM engine) can be run in separate process, using remote executor from state/grpc package. ABCI applications
+// are not run as an Executor, because full ABCI block lifecycle needs more than this interface provides; see
+// NewABCIBlockExecutor.
+type Executor interface {
+	// InitChain initializes the state machine with genesis. It returns the initial state root, and the maximum size of
+	// transactions in a block (0 if consensus params from genesis are used).
+	InitChain(ctx context.Context, genesis *cmtypes.GenesisDoc) (stateRoot []byte, maxBytes uint64, err error)
+
+	// GetTxs returns transactions to be included in the next block, with total size (as computed by
+	// types.ComputeProtoSizeForTxs) of at most maxBytes. Transactions not returned, or not included in the block, are
+	// expected to be returned again, until they're executed.
+	GetTxs(ctx context.Context, maxBytes uint64) ([][]byte, error)
+
+	// ExecuteTxs executes transactions of the block at given height, on top of the state with prevStateRoot, and
+	// commits the updated state. It returns the updated state root, and the maximum size of transactions in the next
+	// block (0 if it's not changed).
+	ExecuteTxs(ctx context.Context, txs [][]byte, height uint64, timestamp time.Time, prevStateRoot []byte) (updatedStateRoot []byte, maxBytes uint64, err error)
+
+	// SetFinal marks the block at given height, and all previous blocks, as final - included in the DA layer. It can be
+	// called again with already final height after restart.
+	SetFinal(ctx context.Context, height uint64) error
+}
+
+// abciApp executes blocks with ABCI application, using transactions from mempool.
+type abciApp struct {
+	proxyApp proxy.AppConnConsensus
+	mempool  mempool.Mempool
+}
+
+// initChain calls InitChain using consensus connection to app.
+func (a *abciApp) initChain(ctx context.Context, genesis *cmtypes.GenesisDoc) (*abci.ResponseInitChain, error) {
+	params := genesis.ConsensusParams
+
+	validators := make([]*cmtypes.Validator, len(genesis.Validators))
+	for i, v := range genesis.Validators {
+		validators[i] = cmtypes.NewValidator(v.PubKey, v.Power)
+	}
+
+	return a.proxyApp.InitChain(ctx, &abci.RequestInitChain{
+		Time:    genesis.GenesisTime,
+		ChainId: genesis.ChainID,
+		ConsensusParams: &cmproto.ConsensusParams{
+			Block: &cmproto.BlockParams{
+				MaxBytes: params.Block.MaxBytes,
+				MaxGas:   params.Block.MaxGas,
+			},
+			Evidence: &cmproto.EvidenceParams{
+				MaxAgeNumBlocks: params.Evidence.MaxAgeNumBlocks,
+				MaxAgeDuration:  params.Evidence.MaxAgeDuration,
+				MaxBytes:        params.Evidence.MaxBytes,
+			},
+			Validator: &cmproto.ValidatorParams{
+				PubKeyTypes: params.Validator.PubKeyTypes,
+			},
+			Version: &cmproto.VersionParams{
+				App: params.Version.App,
+			},
+		},
+		Validators:    cmtypes.TM2PB.ValidatorUpdates(cmtypes.NewValidatorSet(validators)),
+		AppStateBytes: genesis.AppState,
+		InitialHeight: genesis.InitialHeight,
+	})
+}
+
+// commit commits the application state and updates the mempool with executed transactions.
+func (a *abciApp) commit(ctx context.Context, height uint64, txs cmtypes.Txs, results []*abci.ExecTxResult, preCheck mempool.PreCheckFunc, postCheck mempool.PostCheckFunc) (uint64, error) {
+	a.mempool.Lock()
+	defer a.mempool.Unlock()
+
+	err := a.mempool.FlushAppConn()
+	if err != nil {
+		return 0, err
+	}
+
+	commitResp, err := a.proxyApp.Commit(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = a.mempool.Update(height, txs, results, preCheck, postCheck)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(commitResp.RetainHeight), nil
+}
+
+// MockExecutor is a simple state machine intended for testing.
+//
+// Transactions are injected directly to the executor, the state root is a hash of all executed transactions.
+type MockExecutor struct {
+	mtx         sync.Mutex
+	pendingTxs  [][]byte
+	executedTxs [][]byte
+	finalHeight uint64
+}
+
+var _ Executor = &MockExecutor{}
+
+// NewMockExecutor creates new instance of MockExecutor.
+func NewMockExecutor() *MockExecutor {
+	return &MockExecutor{}
+}
+
+// InjectTx adds transaction to be included in the next block.
+func (m *MockExecutor) InjectTx(tx []byte) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.pendingTxs = append(m.pendingTxs, tx)
+}
+
+// InitChain returns the initial state root.
+func (m *MockExecutor) InitChain(_ context.Context, genesis *cmtypes.GenesisDoc) ([]byte, uint64, error) {
+	hash := sha256.Sum256([]byte(genesis.ChainID))
+	return hash[:], 0, nil
+}
+
+// GetTxs returns pending transactions, in order, up to maxBytes.
+func (m *MockExecutor) GetTxs(_ context.Context, maxBytes uint64) ([][]byte, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	var txs [][]byte
+	var size uint64
+	for _, tx := range m.pendingTxs {
+		size += uint64(cmtypes.ComputeProtoSizeForTxs(cmtypes.Txs{tx}))
+		if size > maxBytes {
+			break
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+// ExecuteTxs removes transactions from pending transactions and returns hash of the previous state root and
+// transactions.
+func (m *MockExecutor) ExecuteTxs(_ context.Context, txs [][]byte, _ uint64, _ time.Time, prevStateRoot []byte) ([]byte, uint64, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	h := sha256.New()
+	h.Write(prevStateRoot)
+	for _, tx := range txs {
+		h.Write(tx)
+		m.executedTxs = append(m.executedTxs, tx)
+		for i, pending := range m.pendingTxs {
+			if bytes.Equal(pending, tx) {
+				m.pendingTxs = append(m.pendingTxs[:i], m.pendingTxs[i+1:]...)
+				break
+			}
+		}
+	}
+	return h.Sum(nil), 0, nil
+}
+
+// SetFinal records the final height.
+func (m *MockExecutor) SetFinal(_ context.Context, height uint64) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.finalHeight = height
+	return nil
+}
+
+// ExecutedTxs returns all executed transactions.
+func (m *MockExecutor) ExecutedTxs() [][]byte {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return append([][]byte{}, m.executedTxs...)
+}
+
+// FinalHeight returns the height of the last block marked as final.
+func (m *MockExecutor) FinalHeight() uint64 {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.finalHeight
+}
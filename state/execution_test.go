@@ -0,0 +1,80 @@
+package state
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestBlockExecutorWithExecutor(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	vKey := ed25519.GenPrivKey()
+	exec := NewMockExecutor()
+	executor := NewBlockExecutor(vKey.PubKey().Address().Bytes(), "test", exec, nil, 100, log.TestingLogger(), NopMetrics(), types.GetRandomBytes(32))
+
+	genesis := &cmtypes.GenesisDoc{ChainID: "test", ConsensusParams: cmtypes.DefaultConsensusParams()}
+	res, err := executor.InitChain(genesis)
+	require.NoError(err)
+	genesisRoot := sha256.Sum256([]byte("test"))
+	assert.Equal(genesisRoot[:], res.AppHash)
+	assert.Nil(res.ConsensusParams)
+
+	state := types.State{InitialHeight: 1, AppHash: res.AppHash}
+	state.ConsensusParams.Block = &cmproto.BlockParams{MaxBytes: 100, MaxGas: 100000}
+
+	// only two transactions fit into the block
+	exec.InjectTx([]byte{1, 2, 3, 4})
+	exec.InjectTx([]byte{5, 6, 7, 8})
+	exec.InjectTx(make([]byte, 100))
+	block, err := executor.CreateBlock(1, &types.Commit{}, abci.ExtendedCommitInfo{}, []byte{}, state)
+	require.NoError(err)
+	require.Len(block.Data.Txs, 2)
+	assert.Equal(types.Hash(res.AppHash), block.SignedHeader.AppHash)
+
+	block.SignedHeader.DataHash, err = block.Data.Hash()
+	require.NoError(err)
+	sig, err := vKey.Sign(block.SignedHeader.Header.MakeCometBFTVote())
+	require.NoError(err)
+	block.SignedHeader.Commit = types.Commit{Signatures: []types.Signature{sig}}
+	block.SignedHeader.Validators = cmtypes.NewValidatorSet([]*cmtypes.Validator{cmtypes.NewValidator(vKey.PubKey(), 1)})
+
+	newState, resp, err := executor.ApplyBlock(ctx, state, block)
+	require.NoError(err)
+	require.Len(resp.TxResults, 2)
+	for _, res := range resp.TxResults {
+		assert.Equal(abci.CodeTypeOK, res.Code)
+	}
+	expectedRoot := sha256.Sum256(append(append(genesisRoot[:], 1, 2, 3, 4), 5, 6, 7, 8))
+	assert.Equal(types.Hash(expectedRoot[:]), newState.AppHash)
+	assert.Equal(uint64(1), newState.LastBlockHeight)
+
+	extension, err := executor.ExtendVote(ctx, block)
+	require.NoError(err)
+	assert.Nil(extension)
+
+	appHash, _, err := executor.Commit(ctx, newState, block, resp)
+	require.NoError(err)
+	assert.Equal(expectedRoot[:], appHash)
+
+	// transaction that didn't fit into the block is returned again
+	txs, err := exec.GetTxs(ctx, 1000)
+	require.NoError(err)
+	assert.Equal([][]byte{make([]byte, 100)}, txs)
+	assert.Len(exec.ExecutedTxs(), 2)
+
+	require.NoError(executor.SetFinal(ctx, 1))
+	assert.Equal(uint64(1), exec.FinalHeight())
+}
@@ -30,25 +30,35 @@ type BlockExecutor struct {
 	proposerAddress []byte
 	valsetHash      []byte
 	chainID         string
-	proxyApp        proxy.AppConnConsensus
-	mempool         mempool.Mempool
-	maxBytes        uint64
+	// exec executes blocks, unless they're executed by ABCI application
+	exec Executor
+	// abci is set if blocks are executed by ABCI application, using full ABCI block lifecycle
+	abci     *abciApp
+	maxBytes uint64
 
 	eventBus *cmtypes.EventBus
 
+	// keyProvider is set in encrypted transactions mode
+	keyProvider KeyProvider
+
+	// evpool provides evidence to be included in blocks
+	evpool EvidencePool
+
+	// consensusHashHeight is the height from which ConsensusHash of blocks has to match consensus params
+	consensusHashHeight uint64
+
 	logger log.Logger
 
 	metrics *Metrics
 }
 
-// NewBlockExecutor creates new instance of BlockExecutor.
-func NewBlockExecutor(proposerAddress []byte, chainID string, mempool mempool.Mempool, proxyApp proxy.AppConnConsensus, eventBus *cmtypes.EventBus, maxBytes uint64, logger log.Logger, metrics *Metrics, valsetHash []byte) *BlockExecutor {
+// NewBlockExecutor creates new instance of BlockExecutor, executing blocks with given Executor.
+func NewBlockExecutor(proposerAddress []byte, chainID string, exec Executor, eventBus *cmtypes.EventBus, maxBytes uint64, logger log.Logger, metrics *Metrics, valsetHash []byte) *BlockExecutor {
 	return &BlockExecutor{
 		proposerAddress: proposerAddress,
 		valsetHash:      valsetHash,
 		chainID:         chainID,
-		proxyApp:        proxyApp,
-		mempool:         mempool,
+		exec:            exec,
 		eventBus:        eventBus,
 		maxBytes:        maxBytes,
 		logger:          logger,
@@ -56,42 +66,51 @@ func NewBlockExecutor(proposerAddress []byte, chainID string, mempool mempool.Me
 	}
 }
 
-// InitChain calls InitChainSync using consensus connection to app.
-func (e *BlockExecutor) InitChain(genesis *cmtypes.GenesisDoc) (*abci.ResponseInitChain, error) {
-	params := genesis.ConsensusParams
+// NewABCIBlockExecutor creates new instance of BlockExecutor, executing blocks with ABCI application using full ABCI
+// block lifecycle, with transactions reaped from mempool.
+func NewABCIBlockExecutor(proposerAddress []byte, chainID string, mempool mempool.Mempool, proxyApp proxy.AppConnConsensus, eventBus *cmtypes.EventBus, maxBytes uint64, logger log.Logger, metrics *Metrics, valsetHash []byte) *BlockExecutor {
+	return &BlockExecutor{
+		proposerAddress: proposerAddress,
+		valsetHash:      valsetHash,
+		chainID:         chainID,
+		abci:            &abciApp{proxyApp: proxyApp, mempool: mempool},
+		eventBus:        eventBus,
+		maxBytes:        maxBytes,
+		logger:          logger,
+		metrics:         metrics,
+	}
+}
 
-	validators := make([]*cmtypes.Validator, len(genesis.Validators))
-	for i, v := range genesis.Validators {
-		validators[i] = cmtypes.NewValidator(v.PubKey, v.Power)
+// SetConsensusHashHeight sets the height from which ConsensusHash of validated blocks has to match consensus params.
+//
+// Sequencers used to set zero ConsensusHash, so blocks below this height are accepted also with zero ConsensusHash.
+func (e *BlockExecutor) SetConsensusHashHeight(height uint64) {
+	e.consensusHashHeight = height
+}
+
+// InitChain initializes the chain using the executor.
+func (e *BlockExecutor) InitChain(genesis *cmtypes.GenesisDoc) (*abci.ResponseInitChain, error) {
+	if e.abci != nil {
+		return e.abci.initChain(context.Background(), genesis)
 	}
 
-	return e.proxyApp.InitChain(context.Background(), &abci.RequestInitChain{
-		Time:    genesis.GenesisTime,
-		ChainId: genesis.ChainID,
-		ConsensusParams: &cmproto.ConsensusParams{
+	stateRoot, maxBytes, err := e.exec.InitChain(context.Background(), genesis)
+	if err != nil {
+		return nil, err
+	}
+	res := &abci.ResponseInitChain{AppHash: stateRoot}
+	if maxBytes > 0 {
+		res.ConsensusParams = &cmproto.ConsensusParams{
 			Block: &cmproto.BlockParams{
-				MaxBytes: params.Block.MaxBytes,
-				MaxGas:   params.Block.MaxGas,
-			},
-			Evidence: &cmproto.EvidenceParams{
-				MaxAgeNumBlocks: params.Evidence.MaxAgeNumBlocks,
-				MaxAgeDuration:  params.Evidence.MaxAgeDuration,
-				MaxBytes:        params.Evidence.MaxBytes,
+				MaxBytes: int64(maxBytes),
+				MaxGas:   genesis.ConsensusParams.Block.MaxGas,
 			},
-			Validator: &cmproto.ValidatorParams{
-				PubKeyTypes: params.Validator.PubKeyTypes,
-			},
-			Version: &cmproto.VersionParams{
-				App: params.Version.App,
-			},
-		},
-		Validators:    cmtypes.TM2PB.ValidatorUpdates(cmtypes.NewValidatorSet(validators)),
-		AppStateBytes: genesis.AppState,
-		InitialHeight: genesis.InitialHeight,
-	})
+		}
+	}
+	return res, nil
 }
 
-// CreateBlock reaps transactions from mempool and builds a block.
+// CreateBlock gets transactions from the executor and builds a block.
 func (e *BlockExecutor) CreateBlock(height uint64, lastCommit *types.Commit, lastExtendedCommit abci.ExtendedCommitInfo, lastHeaderHash types.Hash, state types.State) (*types.Block, error) {
 	maxBytes := state.ConsensusParams.Block.MaxBytes
 	emptyMaxBytes := maxBytes == -1
@@ -103,9 +122,10 @@ func (e *BlockExecutor) CreateBlock(height uint64, lastCommit *types.Commit, las
 		maxBytes = int64(e.maxBytes)
 	}
 
-	maxGas := state.ConsensusParams.Block.MaxGas
-
-	mempoolTxs := e.mempool.ReapMaxBytesMaxGas(maxBytes, maxGas)
+	consensusHash, err := types.ConsensusHash(state.ConsensusParams)
+	if err != nil {
+		return nil, err
+	}
 
 	block := &types.Block{
 		SignedHeader: types.SignedHeader{
@@ -117,12 +137,12 @@ func (e *BlockExecutor) CreateBlock(height uint64, lastCommit *types.Commit, las
 				BaseHeader: types.BaseHeader{
 					ChainID: e.chainID,
 					Height:  height,
-					Time:    uint64(time.Now().UnixNano()),
+					Time:    uint64(blockTime(state).UnixNano()),
 				},
 				//LastHeaderHash: lastHeaderHash,
 				//LastCommitHash:  lastCommitHash,
 				DataHash:        make(types.Hash, 32),
-				ConsensusHash:   make(types.Hash, 32),
+				ConsensusHash:   consensusHash,
 				AppHash:         state.AppHash,
 				LastResultsHash: state.LastResultsHash,
 				ProposerAddress: e.proposerAddress,
@@ -130,20 +150,47 @@ func (e *BlockExecutor) CreateBlock(height uint64, lastCommit *types.Commit, las
 			Commit: *lastCommit,
 		},
 		Data: types.Data{
-			Txs: toRollkitTxs(mempoolTxs),
 			// IntermediateStateRoots: types.IntermediateStateRoots{RawRootsList: nil},
-			// Note: Temporarily remove Evidence #896
-			// Evidence:               types.EvidenceData{Evidence: nil},
 		},
 	}
 
-	rpp, err := e.proxyApp.PrepareProposal(
+	evidence, evidenceSize := e.pendingEvidence(state)
+	block.Data.Evidence = types.EvidenceData{Evidence: evidence}
+	maxBytes -= evidenceSize
+
+	var txl cmtypes.Txs
+	if e.abci != nil {
+		txl, err = e.prepareProposal(block, maxBytes, state.ConsensusParams.Block.MaxGas, lastExtendedCommit)
+	} else {
+		txl, err = e.getTxs(maxBytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	block.Data.Txs = toRollkitTxs(txl)
+	block.SignedHeader.LastCommitHash = lastCommit.GetCommitHash(&block.SignedHeader.Header, e.proposerAddress)
+	block.SignedHeader.LastHeaderHash = lastHeaderHash
+
+	return block, nil
+}
+
+// prepareProposal reaps transactions from mempool and passes them to PrepareProposal ABCI method.
+func (e *BlockExecutor) prepareProposal(block *types.Block, maxBytes, maxGas int64, lastExtendedCommit abci.ExtendedCommitInfo) (cmtypes.Txs, error) {
+	mempoolTxs := e.abci.mempool.ReapMaxBytesMaxGas(maxBytes, maxGas)
+	var encryptedTxs cmtypes.Txs
+	if e.keyProvider != nil {
+		encryptedTxs, mempoolTxs = splitEncryptedTxs(mempoolTxs)
+	}
+	maxTxBytes := maxBytes - cmtypes.ComputeProtoSizeForTxs(encryptedTxs)
+
+	rpp, err := e.abci.proxyApp.PrepareProposal(
 		context.TODO(),
 		&abci.RequestPrepareProposal{
-			MaxTxBytes:         maxBytes,
+			MaxTxBytes:         maxTxBytes,
 			Txs:                mempoolTxs.ToSliceOfBytes(),
 			LocalLastCommit:    lastExtendedCommit,
-			Misbehavior:        []abci.Misbehavior{},
+			Misbehavior:        block.Data.Evidence.ToABCI(),
 			Height:             int64(block.Height()),
 			Time:               block.Time(),
 			NextValidatorsHash: e.valsetHash,
@@ -163,32 +210,51 @@ func (e *BlockExecutor) CreateBlock(height uint64, lastCommit *types.Commit, las
 	}
 
 	txl := cmtypes.ToTxs(rpp.Txs)
-	if err := txl.Validate(maxBytes); err != nil {
+	if err := txl.Validate(maxTxBytes); err != nil {
 		return nil, err
 	}
+	if len(encryptedTxs) > 0 {
+		// encrypted transactions are ordered before plaintext transactions
+		txl = e.commitAndReveal(context.TODO(), encryptedTxs, txl)
+	}
+	return txl, nil
+}
 
-	block.Data.Txs = toRollkitTxs(txl)
-	block.SignedHeader.LastCommitHash = lastCommit.GetCommitHash(&block.SignedHeader.Header, e.proposerAddress)
-	block.SignedHeader.LastHeaderHash = lastHeaderHash
-
-	return block, nil
+// getTxs returns transactions from the executor, up to maxBytes.
+func (e *BlockExecutor) getTxs(maxBytes int64) (cmtypes.Txs, error) {
+	if maxBytes <= 0 {
+		return nil, nil
+	}
+	txs, err := e.exec.GetTxs(context.TODO(), uint64(maxBytes))
+	if err != nil {
+		return nil, err
+	}
+	txl := cmtypes.ToTxs(txs)
+	if err := txl.Validate(maxBytes); err != nil {
+		return nil, fmt.Errorf("invalid transactions returned by executor: %w", err)
+	}
+	return txl, nil
 }
 
-// ProcessProposal calls the corresponding ABCI method on the app.
+// ProcessProposal calls the corresponding ABCI method on the app. Blocks are always accepted by non-ABCI executors.
 func (e *BlockExecutor) ProcessProposal(
 	block *types.Block,
 	state types.State,
 ) (bool, error) {
-	resp, err := e.proxyApp.ProcessProposal(context.TODO(), &abci.RequestProcessProposal{
+	if e.abci == nil {
+		return true, nil
+	}
+	txs, _ := e.appTxs(block)
+	resp, err := e.abci.proxyApp.ProcessProposal(context.TODO(), &abci.RequestProcessProposal{
 		Hash:   block.Hash(),
 		Height: int64(block.Height()),
 		Time:   block.Time(),
-		Txs:    block.Data.Txs.ToSliceOfBytes(),
+		Txs:    txs,
 		ProposedLastCommit: abci.CommitInfo{
 			Round: 0,
 			Votes: []abci.VoteInfo{},
 		},
-		Misbehavior:        []abci.Misbehavior{},
+		Misbehavior:        block.Data.Evidence.ToABCI(),
 		ProposerAddress:    e.proposerAddress,
 		NextValidatorsHash: e.valsetHash,
 	})
@@ -238,9 +304,12 @@ func (e *BlockExecutor) ApplyBlock(ctx context.Context, state types.State, block
 	return state, resp, nil
 }
 
-// ExtendVote calls the ExtendVote ABCI method on the proxy app.
+// ExtendVote calls the ExtendVote ABCI method on the proxy app. Non-ABCI executors don't support vote extensions.
 func (e *BlockExecutor) ExtendVote(ctx context.Context, block *types.Block) ([]byte, error) {
-	resp, err := e.proxyApp.ExtendVote(ctx, &abci.RequestExtendVote{
+	if e.abci == nil {
+		return nil, nil
+	}
+	resp, err := e.abci.proxyApp.ExtendVote(ctx, &abci.RequestExtendVote{
 		Hash:   block.Hash(),
 		Height: int64(block.Height()),
 		Time:   block.Time(),
@@ -273,6 +342,12 @@ func (e *BlockExecutor) Commit(ctx context.Context, state types.State, block *ty
 
 	state.AppHash = appHash
 
+	if e.evpool != nil {
+		if err := e.evpool.Update(state, block.Data.Evidence.Evidence); err != nil {
+			e.logger.Error("failed to update evidence pool", "height", block.Height(), "error", err)
+		}
+	}
+
 	e.publishEvents(resp, block, state)
 
 	return appHash, retainHeight, nil
@@ -323,27 +398,29 @@ func (e *BlockExecutor) updateState(state types.State, block *types.Block, final
 }
 
 func (e *BlockExecutor) commit(ctx context.Context, state types.State, block *types.Block, resp *abci.ResponseFinalizeBlock) ([]byte, uint64, error) {
-	e.mempool.Lock()
-	defer e.mempool.Unlock()
-
-	err := e.mempool.FlushAppConn()
-	if err != nil {
-		return nil, 0, err
-	}
-
-	commitResp, err := e.proxyApp.Commit(ctx)
-	if err != nil {
-		return nil, 0, err
+	if e.abci == nil {
+		// state is committed by the executor when block is executed
+		return resp.AppHash, 0, nil
 	}
 
 	maxBytes := state.ConsensusParams.Block.MaxBytes
 	maxGas := state.ConsensusParams.Block.MaxGas
-	err = e.mempool.Update(block.Height(), fromRollkitTxs(block.Data.Txs), resp.TxResults, mempool.PreCheckMaxBytes(maxBytes), mempool.PostCheckMaxGas(maxGas))
+	retainHeight, err := e.abci.commit(ctx, block.Height(), fromRollkitTxs(block.Data.Txs), resp.TxResults, mempool.PreCheckMaxBytes(maxBytes), mempool.PostCheckMaxGas(maxGas))
 	if err != nil {
 		return nil, 0, err
 	}
 
-	return resp.AppHash, uint64(commitResp.RetainHeight), err
+	return resp.AppHash, retainHeight, nil
+}
+
+// SetFinal marks the block at given height as final in the executor.
+//
+// It's a no-op for ABCI application, which commits the state after every block.
+func (e *BlockExecutor) SetFinal(ctx context.Context, height uint64) error {
+	if e.abci != nil {
+		return nil
+	}
+	return e.exec.SetFinal(ctx, height)
 }
 
 // Validate validates the state and the block for the executor
@@ -362,6 +439,9 @@ func (e *BlockExecutor) Validate(state types.State, block *types.Block) error {
 	if state.LastBlockHeight > 0 && block.Height() != state.LastBlockHeight+1 {
 		return errors.New("block height mismatch")
 	}
+	if state.LastBlockHeight > 0 && !block.Time().After(state.LastBlockTime) {
+		return fmt.Errorf("%w: last block time %s, block time %s", types.ErrNonMonotonicTime, state.LastBlockTime, block.Time())
+	}
 	if !bytes.Equal(block.SignedHeader.AppHash[:], state.AppHash[:]) {
 		return errors.New("AppHash mismatch")
 	}
@@ -370,7 +450,31 @@ func (e *BlockExecutor) Validate(state types.State, block *types.Block) error {
 		return errors.New("LastResultsHash mismatch")
 	}
 
-	return nil
+	consensusHash, err := types.ConsensusHash(state.ConsensusParams)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(block.SignedHeader.ConsensusHash, consensusHash) && !e.isLegacyConsensusHash(block) {
+		return errors.New("ConsensusHash mismatch")
+	}
+
+	return e.validateEvidence(state, block)
+}
+
+// isLegacyConsensusHash returns true if the block is below the ConsensusHash activation height, and has zero
+// ConsensusHash, as set by sequencers before it committed to consensus params.
+func (e *BlockExecutor) isLegacyConsensusHash(block *types.Block) bool {
+	return block.Height() < e.consensusHashHeight && bytes.Equal(block.SignedHeader.ConsensusHash, make(types.Hash, 32))
+}
+
+// blockTime returns the time of the next block. Block time is taken from the local clock, but it's always after the
+// time of the last block, so it's strictly increasing even if the clock goes backwards.
+func blockTime(state types.State) time.Time {
+	now := time.Now()
+	if !now.After(state.LastBlockTime) {
+		return state.LastBlockTime.Add(time.Nanosecond)
+	}
+	return now
 }
 
 func (e *BlockExecutor) execute(ctx context.Context, state types.State, block *types.Block) (*abci.ResponseFinalizeBlock, error) {
@@ -380,6 +484,9 @@ func (e *BlockExecutor) execute(ctx context.Context, state types.State, block *t
 		return nil, ctx.Err()
 	default:
 	}
+	if e.abci == nil {
+		return e.executeTxs(ctx, state, block)
+	}
 	abciHeader, err := abciconv.ToABCIHeaderPB(&block.SignedHeader.Header)
 	if err != nil {
 		return nil, err
@@ -390,8 +497,10 @@ func (e *BlockExecutor) execute(ctx context.Context, state types.State, block *t
 		return nil, err
 	}
 
+	txs, results := e.appTxs(block)
+
 	startTime := time.Now().UnixNano()
-	finalizeBlockResponse, err := e.proxyApp.FinalizeBlock(ctx, &abci.RequestFinalizeBlock{
+	finalizeBlockResponse, err := e.abci.proxyApp.FinalizeBlock(ctx, &abci.RequestFinalizeBlock{
 		Hash:               block.Hash(),
 		NextValidatorsHash: e.valsetHash,
 		ProposerAddress:    abciHeader.ProposerAddress,
@@ -402,7 +511,7 @@ func (e *BlockExecutor) execute(ctx context.Context, state types.State, block *t
 			Votes: nil,
 		},
 		Misbehavior: abciBlock.Evidence.Evidence.ToABCI(),
-		Txs:         abciBlock.Txs.ToSliceOfBytes(),
+		Txs:         txs,
 	})
 	endTime := time.Now().UnixNano()
 	e.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
@@ -420,15 +529,51 @@ func (e *BlockExecutor) execute(ctx context.Context, state types.State, block *t
 	)
 
 	// Assert that the application correctly returned tx results for each of the transactions provided in the block
-	if len(abciBlock.Data.Txs) != len(finalizeBlockResponse.TxResults) {
-		return nil, fmt.Errorf("expected tx results length to match size of transactions in block. Expected %d, got %d", len(block.Data.Txs), len(finalizeBlockResponse.TxResults))
+	if len(txs) != len(finalizeBlockResponse.TxResults) {
+		return nil, fmt.Errorf("expected tx results length to match size of transactions in block. Expected %d, got %d", len(txs), len(finalizeBlockResponse.TxResults))
 	}
+	finalizeBlockResponse.TxResults = mergeTxResults(len(block.Data.Txs), finalizeBlockResponse.TxResults, results)
 
 	e.logger.Info("executed block", "height", abciHeader.Height, "app_hash", fmt.Sprintf("%X", finalizeBlockResponse.AppHash))
 
 	return finalizeBlockResponse, nil
 }
 
+// executeTxs executes transactions of the block with non-ABCI executor.
+//
+// Executor returns only the updated state root, so all transactions are reported as successful. Change of maximum block
+// size is reported as consensus params update.
+func (e *BlockExecutor) executeTxs(ctx context.Context, state types.State, block *types.Block) (*abci.ResponseFinalizeBlock, error) {
+	startTime := time.Now().UnixNano()
+	stateRoot, maxBytes, err := e.exec.ExecuteTxs(ctx, block.Data.Txs.ToSliceOfBytes(), block.Height(), block.Time(), state.AppHash)
+	endTime := time.Now().UnixNano()
+	e.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
+	if err != nil {
+		e.logger.Error("error in executor.ExecuteTxs", "err", err)
+		return nil, err
+	}
+
+	resp := &abci.ResponseFinalizeBlock{
+		TxResults: make([]*abci.ExecTxResult, len(block.Data.Txs)),
+		AppHash:   stateRoot,
+	}
+	for i := range resp.TxResults {
+		resp.TxResults[i] = &abci.ExecTxResult{Code: abci.CodeTypeOK}
+	}
+	if maxBytes > 0 && int64(maxBytes) != state.ConsensusParams.Block.MaxBytes {
+		resp.ConsensusParamUpdates = &cmproto.ConsensusParams{
+			Block: &cmproto.BlockParams{
+				MaxBytes: int64(maxBytes),
+				MaxGas:   state.ConsensusParams.Block.MaxGas,
+			},
+		}
+	}
+
+	e.logger.Info("executed block", "height", block.Height(), "app_hash", fmt.Sprintf("%X", stateRoot))
+
+	return resp, nil
+}
+
 func (e *BlockExecutor) publishEvents(resp *abci.ResponseFinalizeBlock, block *types.Block, state types.State) {
 	if e.eventBus == nil {
 		return
@@ -50,7 +50,7 @@ func doTestCreateBlock(t *testing.T) {
 	fmt.Println("Made NID")
 	mpool := mempool.NewCListMempool(cfg.DefaultMempoolConfig(), proxy.NewAppConnMempool(client, proxy.NopMetrics()), 0)
 	fmt.Println("Made a NewTxMempool")
-	executor := NewBlockExecutor([]byte("test address"), "test", mpool, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), nil, 100, logger, NopMetrics(), types.GetRandomBytes(32))
+	executor := NewABCIBlockExecutor([]byte("test address"), "test", mpool, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), nil, 100, logger, NopMetrics(), types.GetRandomBytes(32))
 	fmt.Println("Made a New Block Executor")
 
 	state := types.State{}
@@ -167,7 +167,7 @@ func doTestApplyBlock(t *testing.T) {
 	state.ConsensusParams.Block.MaxBytes = 100
 	state.ConsensusParams.Block.MaxGas = 100000
 	chainID := "test"
-	executor := NewBlockExecutor(vKey.PubKey().Address().Bytes(), chainID, mpool, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), eventBus, 100, logger, NopMetrics(), types.GetRandomBytes(32))
+	executor := NewABCIBlockExecutor(vKey.PubKey().Address().Bytes(), chainID, mpool, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), eventBus, 100, logger, NopMetrics(), types.GetRandomBytes(32))
 
 	err = mpool.CheckTx([]byte{1, 2, 3, 4}, func(r *abci.ResponseCheckTx) {}, mempool.TxInfo{})
 	require.NoError(err)
@@ -188,6 +188,24 @@ func doTestApplyBlock(t *testing.T) {
 	}
 	block.SignedHeader.Validators = cmtypes.NewValidatorSet(validators)
 
+	// block must commit to consensus params from the state
+	consensusHash := block.SignedHeader.ConsensusHash
+	block.SignedHeader.ConsensusHash = types.GetRandomBytes(32)
+	require.ErrorContains(executor.Validate(state, block), "ConsensusHash mismatch")
+
+	// legacy zero ConsensusHash is accepted only below the activation height
+	block.SignedHeader.ConsensusHash = make(types.Hash, 32)
+	require.ErrorContains(executor.Validate(state, block), "ConsensusHash mismatch")
+	executor.SetConsensusHashHeight(block.Height() + 1)
+	require.NoError(executor.Validate(state, block))
+	block.SignedHeader.ConsensusHash = types.GetRandomBytes(32)
+	require.ErrorContains(executor.Validate(state, block), "ConsensusHash mismatch")
+	executor.SetConsensusHashHeight(block.Height())
+	block.SignedHeader.ConsensusHash = make(types.Hash, 32)
+	require.ErrorContains(executor.Validate(state, block), "ConsensusHash mismatch")
+	executor.SetConsensusHashHeight(0)
+	block.SignedHeader.ConsensusHash = consensusHash
+
 	newState, resp, err := executor.ApplyBlock(context.Background(), state, block)
 	require.NoError(err)
 	require.NotNil(newState)
@@ -270,7 +288,7 @@ func TestUpdateStateConsensusParams(t *testing.T) {
 	mpool := mempool.NewCListMempool(cfg.DefaultMempoolConfig(), proxy.NewAppConnMempool(client, proxy.NopMetrics()), 0)
 	eventBus := cmtypes.NewEventBus()
 	require.NoError(t, eventBus.Start())
-	executor := NewBlockExecutor([]byte("test address"), chainID, mpool, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), eventBus, 100, logger, NopMetrics(), types.GetRandomBytes(32))
+	executor := NewABCIBlockExecutor([]byte("test address"), chainID, mpool, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), eventBus, 100, logger, NopMetrics(), types.GetRandomBytes(32))
 
 	state := types.State{
 		ConsensusParams: cmproto.ConsensusParams{
@@ -309,6 +327,14 @@ func TestUpdateStateConsensusParams(t *testing.T) {
 			Version: &cmproto.VersionParams{
 				App: 2,
 			},
+			Evidence: &cmproto.EvidenceParams{
+				MaxAgeNumBlocks: 1000,
+				MaxAgeDuration:  time.Hour,
+				MaxBytes:        5000,
+			},
+			Abci: &cmproto.ABCIParams{
+				VoteExtensionsEnableHeight: 2000,
+			},
 		},
 		TxResults: txResults,
 	}
@@ -320,4 +346,20 @@ func TestUpdateStateConsensusParams(t *testing.T) {
 	assert.Equal(t, int64(200), updatedState.ConsensusParams.Block.MaxBytes)
 	assert.Equal(t, int64(200000), updatedState.ConsensusParams.Block.MaxGas)
 	assert.Equal(t, uint64(2), updatedState.ConsensusParams.Version.App)
+	assert.Equal(t, int64(1000), updatedState.ConsensusParams.Evidence.MaxAgeNumBlocks)
+	assert.Equal(t, time.Hour, updatedState.ConsensusParams.Evidence.MaxAgeDuration)
+	assert.Equal(t, int64(5000), updatedState.ConsensusParams.Evidence.MaxBytes)
+	assert.Equal(t, int64(2000), updatedState.ConsensusParams.Abci.VoteExtensionsEnableHeight)
+	assert.Equal(t, uint64(2), updatedState.Version.Consensus.App)
+
+	// header of the next block commits to updated params
+	hash, err := types.ConsensusHash(state.ConsensusParams)
+	require.NoError(t, err)
+	updatedHash, err := types.ConsensusHash(updatedState.ConsensusParams)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, updatedHash)
+	updatedState.ConsensusParams.Evidence.MaxBytes = 6000
+	changedHash, err := types.ConsensusHash(updatedState.ConsensusParams)
+	require.NoError(t, err)
+	assert.NotEqual(t, updatedHash, changedHash)
 }
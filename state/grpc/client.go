@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	cmtypes "github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc"
+
+	"github.com/rollkit/rollkit/state"
+	pb "github.com/rollkit/rollkit/types/pb/rollkit"
+)
+
+// Client is a gRPC client of remote executor, running the state machine in separate process.
+type Client struct {
+	conn *grpc.ClientConn
+
+	client pb.ExecutorServiceClient
+}
+
+var _ state.Executor = &Client{}
+
+// NewClient returns new Client instance.
+func NewClient() *Client {
+	return &Client{}
+}
+
+// Start connects Client to target, with given options.
+func (c *Client) Start(target string, opts ...grpc.DialOption) (err error) {
+	c.conn, err = grpc.Dial(target, opts...)
+	if err != nil {
+		return err
+	}
+	c.client = pb.NewExecutorServiceClient(c.conn)
+
+	return nil
+}
+
+// Stop gently closes Client connection.
+func (c *Client) Stop() error {
+	return c.conn.Close()
+}
+
+// InitChain initializes the remote state machine with genesis.
+func (c *Client) InitChain(ctx context.Context, genesis *cmtypes.GenesisDoc) ([]byte, uint64, error) {
+	req := &pb.InitChainRequest{
+		GenesisTime:   genesis.GenesisTime,
+		InitialHeight: uint64(genesis.InitialHeight),
+		ChainId:       genesis.ChainID,
+		AppState:      genesis.AppState,
+	}
+	resp, err := c.client.InitChain(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp.StateRoot, resp.MaxBytes, nil
+}
+
+// GetTxs returns transactions to be included in the next block, up to maxBytes.
+func (c *Client) GetTxs(ctx context.Context, maxBytes uint64) ([][]byte, error) {
+	resp, err := c.client.GetTxs(ctx, &pb.GetTxsRequest{MaxBytes: maxBytes})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Txs, nil
+}
+
+// ExecuteTxs executes transactions of the block by the remote state machine.
+func (c *Client) ExecuteTxs(ctx context.Context, txs [][]byte, height uint64, timestamp time.Time, prevStateRoot []byte) ([]byte, uint64, error) {
+	req := &pb.ExecuteTxsRequest{
+		Txs:           txs,
+		Height:        height,
+		Timestamp:     timestamp,
+		PrevStateRoot: prevStateRoot,
+	}
+	resp, err := c.client.ExecuteTxs(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp.UpdatedStateRoot, resp.MaxBytes, nil
+}
+
+// SetFinal marks the block at given height as final.
+func (c *Client) SetFinal(ctx context.Context, height uint64) error {
+	_, err := c.client.SetFinal(ctx, &pb.SetFinalRequest{Height: height})
+	return err
+}
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/rollkit/rollkit/state"
+)
+
+func TestRemoteExecutor(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	local := state.NewMockExecutor()
+	server := NewServer(local, grpc.Creds(insecure.NewCredentials()))
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+
+	client := NewClient()
+	require.NoError(client.Start(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials())))
+	defer func() { require.NoError(client.Stop()) }()
+
+	genesis := &cmtypes.GenesisDoc{ChainID: "test", GenesisTime: time.Now(), InitialHeight: 1}
+	expectedRoot, _, err := state.NewMockExecutor().InitChain(ctx, genesis)
+	require.NoError(err)
+	stateRoot, maxBytes, err := client.InitChain(ctx, genesis)
+	require.NoError(err)
+	assert.Equal(expectedRoot, stateRoot)
+	assert.Zero(maxBytes)
+
+	txs, err := client.GetTxs(ctx, 1000)
+	require.NoError(err)
+	assert.Empty(txs)
+
+	local.InjectTx([]byte{1, 2, 3})
+	local.InjectTx([]byte{4, 5, 6})
+	txs, err = client.GetTxs(ctx, 1000)
+	require.NoError(err)
+	assert.Equal([][]byte{{1, 2, 3}, {4, 5, 6}}, txs)
+	// size of each transaction is 5 bytes, with protobuf encoding overhead
+	txs, err = client.GetTxs(ctx, 9)
+	require.NoError(err)
+	assert.Equal([][]byte{{1, 2, 3}}, txs)
+
+	expectedRoot, _, err = state.NewMockExecutor().ExecuteTxs(ctx, txs[:1], 1, time.Now(), stateRoot)
+	require.NoError(err)
+	stateRoot, _, err = client.ExecuteTxs(ctx, txs[:1], 1, time.Now(), stateRoot)
+	require.NoError(err)
+	assert.Equal(expectedRoot, stateRoot)
+	assert.Equal([][]byte{{1, 2, 3}}, local.ExecutedTxs())
+
+	require.NoError(client.SetFinal(ctx, 1))
+	assert.Equal(uint64(1), local.FinalHeight())
+}
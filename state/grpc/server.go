@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+
+	cmtypes "github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc"
+
+	"github.com/rollkit/rollkit/state"
+	pb "github.com/rollkit/rollkit/types/pb/rollkit"
+)
+
+// NewServer creates new gRPC Server configured to serve the executor.
+//
+// It can be used to run the state machine in separate process, with rollkit node connecting to it with Client.
+func NewServer(executor state.Executor, opts ...grpc.ServerOption) *grpc.Server {
+	srv := grpc.NewServer(opts...)
+
+	pb.RegisterExecutorServiceServer(srv, &executorSrv{target: executor})
+
+	return srv
+}
+
+type executorSrv struct {
+	target state.Executor
+}
+
+func (e *executorSrv) InitChain(ctx context.Context, request *pb.InitChainRequest) (*pb.InitChainResponse, error) {
+	genesis := &cmtypes.GenesisDoc{
+		GenesisTime:   request.GenesisTime,
+		ChainID:       request.ChainId,
+		InitialHeight: int64(request.InitialHeight),
+		AppState:      request.AppState,
+	}
+	if err := genesis.ValidateAndComplete(); err != nil {
+		return nil, err
+	}
+	stateRoot, maxBytes, err := e.target.InitChain(ctx, genesis)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InitChainResponse{StateRoot: stateRoot, MaxBytes: maxBytes}, nil
+}
+
+func (e *executorSrv) GetTxs(ctx context.Context, request *pb.GetTxsRequest) (*pb.GetTxsResponse, error) {
+	txs, err := e.target.GetTxs(ctx, request.MaxBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTxsResponse{Txs: txs}, nil
+}
+
+func (e *executorSrv) ExecuteTxs(ctx context.Context, request *pb.ExecuteTxsRequest) (*pb.ExecuteTxsResponse, error) {
+	stateRoot, maxBytes, err := e.target.ExecuteTxs(ctx, request.Txs, request.Height, request.Timestamp, request.PrevStateRoot)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExecuteTxsResponse{UpdatedStateRoot: stateRoot, MaxBytes: maxBytes}, nil
+}
+
+func (e *executorSrv) SetFinal(ctx context.Context, request *pb.SetFinalRequest) (*pb.SetFinalResponse, error) {
+	if err := e.target.SetFinal(ctx, request.Height); err != nil {
+		return nil, err
+	}
+	return &pb.SetFinalResponse{}, nil
+}
@@ -0,0 +1,335 @@
+package store
+
+import (
+	"context"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// DefaultCacheSize is the default number of entries of each kind (blocks, commits, responses) kept by CachedStore.
+const DefaultCacheSize = 1000
+
+// Types of cached data, used as metric labels.
+const (
+	cacheTypeBlock     = "block"
+	cacheTypeCommit    = "commit"
+	cacheTypeResponses = "responses"
+	cacheTypeState     = "state"
+)
+
+// CachedStore is a read-through cache of recently used blocks, commits, block responses and the state, on top of
+// another Store.
+//
+// Cache entries are updated on writes, so cached data is never stale. Copies of cached values are returned, so callers
+// are free to modify them, like values read from the underlying store.
+type CachedStore struct {
+	Store
+
+	// hashes of blocks by height
+	index     *lru.Cache[uint64, string]
+	blocks    *lru.Cache[string, *types.Block]
+	commits   *lru.Cache[string, *types.Commit]
+	responses *lru.Cache[uint64, *abci.ResponseFinalizeBlock]
+
+	stateMtx sync.RWMutex
+	state    *types.State
+
+	metrics *Metrics
+}
+
+var _ Store = &CachedStore{}
+
+// NewCachedStore returns a store caching up to size entries of each kind of data read from or written to the given
+// store.
+func NewCachedStore(store Store, size int, metrics *Metrics) (*CachedStore, error) {
+	index, err := lru.New[uint64, string](size)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := lru.New[string, *types.Block](size)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := lru.New[string, *types.Commit](size)
+	if err != nil {
+		return nil, err
+	}
+	responses, err := lru.New[uint64, *abci.ResponseFinalizeBlock](size)
+	if err != nil {
+		return nil, err
+	}
+	return &CachedStore{
+		Store:     store,
+		index:     index,
+		blocks:    blocks,
+		commits:   commits,
+		responses: responses,
+		metrics:   metrics,
+	}, nil
+}
+
+// SaveBlock saves block and commit in the underlying store and in the cache.
+//
+// Copies of block and commit are cached, as callers are free to modify them after saving.
+func (s *CachedStore) SaveBlock(ctx context.Context, block *types.Block, commit *types.Commit) error {
+	s.invalidate(block.Height())
+	if err := s.Store.SaveBlock(ctx, block, commit); err != nil {
+		return err
+	}
+	// block and commit were already marshaled by the underlying store, so cloning them shouldn't fail; if it does, they
+	// are simply not cached
+	blockCopy, blockErr := cloneBlock(block)
+	commitCopy, commitErr := cloneCommit(commit)
+	if blockErr == nil && commitErr == nil {
+		hash := string(blockCopy.Hash())
+		s.index.Add(blockCopy.Height(), hash)
+		s.blocks.Add(hash, blockCopy)
+		s.commits.Add(hash, commitCopy)
+	}
+	return nil
+}
+
+// GetBlock returns block at given height, from cache if possible.
+func (s *CachedStore) GetBlock(ctx context.Context, height uint64) (*types.Block, error) {
+	if hash, ok := s.index.Get(height); ok {
+		if block, ok := s.getCachedBlock(hash); ok {
+			return block, nil
+		}
+	}
+	s.miss(cacheTypeBlock)
+	block, err := s.Store.GetBlock(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	s.addBlock(block)
+	return block, nil
+}
+
+// GetBlockByHash returns block with given hash, from cache if possible.
+func (s *CachedStore) GetBlockByHash(ctx context.Context, hash types.Hash) (*types.Block, error) {
+	if block, ok := s.getCachedBlock(string(hash)); ok {
+		return block, nil
+	}
+	s.miss(cacheTypeBlock)
+	block, err := s.Store.GetBlockByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if blockCopy, err := cloneBlock(block); err == nil {
+		s.blocks.Add(string(hash), blockCopy)
+	}
+	return block, nil
+}
+
+// GetHeader returns signed header of the block at given height, from cached block if possible.
+func (s *CachedStore) GetHeader(ctx context.Context, height uint64) (*types.SignedHeader, error) {
+	if hash, ok := s.index.Get(height); ok {
+		if block, ok := s.getCachedBlock(hash); ok {
+			return &block.SignedHeader, nil
+		}
+	}
+	s.miss(cacheTypeBlock)
+	return s.Store.GetHeader(ctx, height)
+}
+
+// GetCommit returns commit for a block at given height, from cache if possible.
+func (s *CachedStore) GetCommit(ctx context.Context, height uint64) (*types.Commit, error) {
+	if hash, ok := s.index.Get(height); ok {
+		if commit, ok := s.getCachedCommit(hash); ok {
+			return commit, nil
+		}
+	}
+	s.miss(cacheTypeCommit)
+	commit, err := s.Store.GetCommit(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	// commit is cached only if block hash at this height is known
+	if hash, ok := s.index.Get(height); ok {
+		s.addCommit(hash, commit)
+	}
+	return commit, nil
+}
+
+// GetCommitByHash returns commit for a block with given hash, from cache if possible.
+func (s *CachedStore) GetCommitByHash(ctx context.Context, hash types.Hash) (*types.Commit, error) {
+	if commit, ok := s.getCachedCommit(string(hash)); ok {
+		return commit, nil
+	}
+	s.miss(cacheTypeCommit)
+	commit, err := s.Store.GetCommitByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	s.addCommit(string(hash), commit)
+	return commit, nil
+}
+
+// SaveBlockResponses saves block responses in the underlying store and in the cache.
+func (s *CachedStore) SaveBlockResponses(ctx context.Context, height uint64, responses *abci.ResponseFinalizeBlock) error {
+	s.responses.Remove(height)
+	if err := s.Store.SaveBlockResponses(ctx, height, responses); err != nil {
+		return err
+	}
+	s.responses.Add(height, proto.Clone(responses).(*abci.ResponseFinalizeBlock))
+	return nil
+}
+
+// GetBlockResponses returns block responses at given height, from cache if possible.
+func (s *CachedStore) GetBlockResponses(ctx context.Context, height uint64) (*abci.ResponseFinalizeBlock, error) {
+	if responses, ok := s.responses.Get(height); ok {
+		s.hit(cacheTypeResponses)
+		return proto.Clone(responses).(*abci.ResponseFinalizeBlock), nil
+	}
+	s.miss(cacheTypeResponses)
+	responses, err := s.Store.GetBlockResponses(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	s.responses.Add(height, proto.Clone(responses).(*abci.ResponseFinalizeBlock))
+	return responses, nil
+}
+
+// UpdateState saves state in the underlying store and in the cache.
+func (s *CachedStore) UpdateState(ctx context.Context, state types.State) error {
+	s.stateMtx.Lock()
+	defer s.stateMtx.Unlock()
+	if err := s.Store.UpdateState(ctx, state); err != nil {
+		s.state = nil
+		return err
+	}
+	s.state = nil
+	if stateCopy, err := cloneState(state); err == nil {
+		s.state = &stateCopy
+	}
+	return nil
+}
+
+// GetState returns the last saved state, from cache if possible.
+func (s *CachedStore) GetState(ctx context.Context) (types.State, error) {
+	s.stateMtx.RLock()
+	state := s.state
+	s.stateMtx.RUnlock()
+	if state != nil {
+		if stateCopy, err := cloneState(*state); err == nil {
+			s.hit(cacheTypeState)
+			return stateCopy, nil
+		}
+	}
+
+	s.miss(cacheTypeState)
+	s.stateMtx.Lock()
+	defer s.stateMtx.Unlock()
+	loaded, err := s.Store.GetState(ctx)
+	if err != nil {
+		return types.State{}, err
+	}
+	if stateCopy, err := cloneState(loaded); err == nil {
+		s.state = &stateCopy
+	}
+	return loaded, nil
+}
+
+// InvalidateState removes the state from the cache, so it's loaded from the underlying store on next read.
+//
+// It's used when the underlying store is written by another process.
+func (s *CachedStore) InvalidateState() {
+	s.stateMtx.Lock()
+	defer s.stateMtx.Unlock()
+	s.state = nil
+}
+
+// invalidate removes block and commit at given height from the cache.
+func (s *CachedStore) invalidate(height uint64) {
+	if hash, ok := s.index.Peek(height); ok {
+		s.blocks.Remove(hash)
+		s.commits.Remove(hash)
+	}
+	s.index.Remove(height)
+}
+
+// getCachedBlock returns a copy of the cached block with given hash.
+func (s *CachedStore) getCachedBlock(hash string) (*types.Block, bool) {
+	block, ok := s.blocks.Get(hash)
+	if !ok {
+		return nil, false
+	}
+	blockCopy, err := cloneBlock(block)
+	if err != nil {
+		return nil, false
+	}
+	s.hit(cacheTypeBlock)
+	return blockCopy, true
+}
+
+// addBlock caches a copy of block read from the underlying store.
+func (s *CachedStore) addBlock(block *types.Block) {
+	blockCopy, err := cloneBlock(block)
+	if err != nil {
+		return
+	}
+	hash := string(blockCopy.Hash())
+	s.index.Add(blockCopy.Height(), hash)
+	s.blocks.Add(hash, blockCopy)
+}
+
+// getCachedCommit returns a copy of the cached commit for a block with given hash.
+func (s *CachedStore) getCachedCommit(hash string) (*types.Commit, bool) {
+	commit, ok := s.commits.Get(hash)
+	if !ok {
+		return nil, false
+	}
+	commitCopy, err := cloneCommit(commit)
+	if err != nil {
+		return nil, false
+	}
+	s.hit(cacheTypeCommit)
+	return commitCopy, true
+}
+
+// addCommit caches a copy of commit read from the underlying store.
+func (s *CachedStore) addCommit(hash string, commit *types.Commit) {
+	if commitCopy, err := cloneCommit(commit); err == nil {
+		s.commits.Add(hash, commitCopy)
+	}
+}
+
+func cloneBlock(block *types.Block) (*types.Block, error) {
+	data, err := block.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	clone := new(types.Block)
+	return clone, clone.UnmarshalBinary(data)
+}
+
+func cloneCommit(commit *types.Commit) (*types.Commit, error) {
+	data, err := commit.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	clone := new(types.Commit)
+	return clone, clone.UnmarshalBinary(data)
+}
+
+func cloneState(state types.State) (types.State, error) {
+	pbState, err := state.ToProto()
+	if err != nil {
+		return types.State{}, err
+	}
+	var clone types.State
+	return clone, clone.FromProto(pbState)
+}
+
+func (s *CachedStore) hit(typ string) {
+	s.metrics.CacheHits.With("type", typ).Add(1)
+}
+
+func (s *CachedStore) miss(typ string) {
+	s.metrics.CacheMisses.With("type", typ).Add(1)
+}
@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// testCounter counts values by label values.
+type testCounter struct {
+	values map[string]float64
+	labels []string
+}
+
+func (c *testCounter) With(labelValues ...string) metrics.Counter {
+	return &testCounter{values: c.values, labels: append(append([]string{}, c.labels...), labelValues...)}
+}
+
+func (c *testCounter) Add(delta float64) {
+	c.values[strings.Join(c.labels, "=")] += delta
+}
+
+func TestCachedStore(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	hits := &testCounter{values: make(map[string]float64)}
+	misses := &testCounter{values: make(map[string]float64)}
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	base := New(kv)
+	blocks, privKey, state := saveTestChain(t, base, 3)
+
+	s, err := NewCachedStore(base, 10, &Metrics{CacheHits: hits, CacheMisses: misses})
+	require.NoError(err)
+
+	// read-through
+	for i := 0; i < 2; i++ {
+		block, err := s.GetBlock(ctx, 2)
+		require.NoError(err)
+		assert.Equal(blocks[1], block)
+		commit, err := s.GetCommit(ctx, 2)
+		require.NoError(err)
+		assert.Equal(blocks[1].SignedHeader.Commit, *commit)
+		responses, err := s.GetBlockResponses(ctx, 2)
+		require.NoError(err)
+		assert.Equal([]byte(blocks[1].SignedHeader.AppHash), responses.AppHash)
+		st, err := s.GetState(ctx)
+		require.NoError(err)
+		assert.Equal(state.LastBlockID, st.LastBlockID)
+	}
+	header, err := s.GetHeader(ctx, 2)
+	require.NoError(err)
+	assert.Equal(&blocks[1].SignedHeader, header)
+	_, err = s.GetBlockByHash(ctx, blocks[1].Hash())
+	require.NoError(err)
+	for _, typ := range []string{cacheTypeBlock, cacheTypeCommit, cacheTypeResponses, cacheTypeState} {
+		assert.Equal(float64(1), misses.values["type="+typ], typ)
+	}
+	assert.Equal(float64(3), hits.values["type="+cacheTypeBlock])
+	assert.Equal(float64(1), hits.values["type="+cacheTypeCommit])
+
+	// writes update the cache, with copies of saved data
+	next := types.GetRandomNextBlock(blocks[2], privKey, types.GetRandomBytes(32), 1)
+	require.NoError(s.SaveBlock(ctx, next, &next.SignedHeader.Commit))
+	require.NoError(s.SaveBlockResponses(ctx, 4, &abci.ResponseFinalizeBlock{AppHash: []byte{1}}))
+	state.LastBlockHeight = 4
+	require.NoError(s.UpdateState(ctx, state))
+	expectedHash := next.Hash()
+	next.SignedHeader.AppHash = types.GetRandomBytes(32)
+
+	missesBefore := misses.values["type="+cacheTypeBlock]
+	block, err := s.GetBlock(ctx, 4)
+	require.NoError(err)
+	assert.Equal(expectedHash, block.Hash())
+	responses, err := s.GetBlockResponses(ctx, 4)
+	require.NoError(err)
+	assert.Equal([]byte{1}, responses.AppHash)
+	st, err := s.GetState(ctx)
+	require.NoError(err)
+	assert.Equal(uint64(4), st.LastBlockHeight)
+	assert.Equal(missesBefore, misses.values["type="+cacheTypeBlock])
+
+	// overwriting block at the same height invalidates cached block
+	other := types.GetRandomNextBlock(blocks[2], privKey, types.GetRandomBytes(32), 2)
+	require.NoError(s.SaveBlock(ctx, other, &other.SignedHeader.Commit))
+	block, err = s.GetBlock(ctx, 4)
+	require.NoError(err)
+	assert.Equal(other.Hash(), block.Hash())
+	commit, err := s.GetCommit(ctx, 4)
+	require.NoError(err)
+	assert.Equal(other.SignedHeader.Commit, *commit)
+
+	// returned values are copies, so modifying them doesn't affect the cache
+	block.SignedHeader.DataHash = types.GetRandomBytes(32)
+	block.Data.Txs = nil
+	commit.Signatures = nil
+	responses.AppHash = []byte{2}
+	st.LastBlockHeight = 5
+	block, err = s.GetBlock(ctx, 4)
+	require.NoError(err)
+	assert.Equal(other, block)
+	header, err = s.GetHeader(ctx, 4)
+	require.NoError(err)
+	assert.Equal(other.Hash(), header.Hash())
+	commit, err = s.GetCommit(ctx, 4)
+	require.NoError(err)
+	assert.Equal(other.SignedHeader.Commit, *commit)
+	responses, err = s.GetBlockResponses(ctx, 4)
+	require.NoError(err)
+	assert.Equal([]byte{1}, responses.AppHash)
+	st, err = s.GetState(ctx)
+	require.NoError(err)
+	assert.Equal(uint64(4), st.LastBlockHeight)
+	assert.Equal(missesBefore, misses.values["type="+cacheTypeBlock])
+}
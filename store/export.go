@@ -0,0 +1,319 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/rollkit/rollkit/types"
+	pb "github.com/rollkit/rollkit/types/pb/rollkit"
+)
+
+// ExportVersion is the version of the chain export file format.
+const ExportVersion uint64 = 1
+
+// exportMagic is written at the beginning of every chain export file.
+var exportMagic = []byte("rollkit-export\n")
+
+// maxExportRecordSize is the maximum size of a single record in chain export file.
+const maxExportRecordSize = 1 << 30
+
+var (
+	// ErrInvalidExport is returned when the chain export file is malformed or corrupted.
+	ErrInvalidExport = errors.New("invalid chain export")
+
+	// ErrStoreNotEmpty is returned when importing into a store that already contains a chain.
+	ErrStoreNotEmpty = errors.New("store is not empty")
+
+	// ErrUntrustedExport is returned when the first imported block doesn't match the trusted proposer or hash.
+	ErrUntrustedExport = errors.New("untrusted chain export")
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Export writes blocks (with commits and block responses) from the given height range, followed by the final state,
+// to w.
+//
+// The file is a sequence of length-prefixed ExportRecord protobuf messages, each followed by its CRC-32C checksum. The
+// state is written only if the last exported block is the last block of the state.
+func Export(ctx context.Context, s Store, w io.Writer, from, to uint64) error {
+	if from == 0 || from > to {
+		return fmt.Errorf("invalid height range: %d-%d", from, to)
+	}
+	state, err := s.GetState(ctx)
+	if err != nil {
+		return err
+	}
+	if to > state.LastBlockHeight {
+		return fmt.Errorf("height %d is above the last block height %d", to, state.LastBlockHeight)
+	}
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(exportMagic); err != nil {
+		return err
+	}
+	err = writeExportRecord(bw, &pb.ExportRecord{Record: &pb.ExportRecord_Header{Header: &pb.ExportHeader{
+		Version:    ExportVersion,
+		ChainId:    state.ChainID,
+		FromHeight: from,
+		ToHeight:   to,
+	}}})
+	if err != nil {
+		return err
+	}
+
+	for height := from; height <= to; height++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		block, err := s.GetBlock(ctx, height)
+		if err != nil {
+			return err
+		}
+		commit, err := s.GetCommit(ctx, height)
+		if err != nil {
+			return err
+		}
+		responses, err := s.GetBlockResponses(ctx, height)
+		if err != nil {
+			return err
+		}
+		pbBlock, err := block.ToProto()
+		if err != nil {
+			return err
+		}
+		err = writeExportRecord(bw, &pb.ExportRecord{Record: &pb.ExportRecord_Block{Block: &pb.ExportBlock{
+			Block:     pbBlock,
+			Commit:    commit.ToProto(),
+			Responses: responses,
+		}}})
+		if err != nil {
+			return fmt.Errorf("failed to export block at height %d: %w", height, err)
+		}
+	}
+
+	if to == state.LastBlockHeight {
+		pbState, err := state.ToProto()
+		if err != nil {
+			return err
+		}
+		if err := writeExportRecord(bw, &pb.ExportRecord{Record: &pb.ExportRecord_State{State: pbState}}); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// ImportResult describes the chain imported by Import.
+type ImportResult struct {
+	FromHeight uint64
+	ToHeight   uint64
+	// StateImported is false if the export didn't contain the final state.
+	StateImported bool
+}
+
+// ImportTrust is the root of trust of the imported chain. Blocks are only verified against each other, so the first
+// imported block has to be checked against a trusted source.
+type ImportTrust struct {
+	// ProposerAddress is the address of the trusted proposer (e.g. the sequencer from genesis). If set, the first
+	// block must be proposed and signed by it.
+	ProposerAddress []byte
+	// Hash is the trusted hash of the first imported block. If set, the first block must have this hash.
+	Hash types.Hash
+}
+
+// Import loads blocks and state written by Export into an empty store.
+//
+// Checksums of all the records are verified. Blocks are validated and each block is verified against the previous
+// one with SignedHeader.Verify, so signatures and hash links are checked, and the first block is checked against
+// trust, which must have the proposer address or hash set. Exported commits must be the commits of the blocks. The
+// state is verified against the last block.
+func Import(ctx context.Context, s Store, r io.Reader, trust ImportTrust) (*ImportResult, error) {
+	if len(trust.ProposerAddress) == 0 && len(trust.Hash) == 0 {
+		return nil, fmt.Errorf("%w: trusted proposer address or hash is required", ErrUntrustedExport)
+	}
+	_, err := s.GetState(ctx)
+	if err == nil {
+		return nil, ErrStoreNotEmpty
+	}
+	if !errors.Is(err, ds.ErrNotFound) {
+		return nil, err
+	}
+
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(exportMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || !bytes.Equal(magic, exportMagic) {
+		return nil, fmt.Errorf("%w: not a chain export file", ErrInvalidExport)
+	}
+	record, err := readExportRecord(br)
+	if err != nil {
+		return nil, err
+	}
+	header := record.GetHeader()
+	if header == nil {
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidExport)
+	}
+	if header.Version != ExportVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidExport, header.Version)
+	}
+
+	var last *types.Block
+	var state *pb.State
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		record, err := readExportRecord(br)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if state != nil {
+			return nil, fmt.Errorf("%w: state is not the last record", ErrInvalidExport)
+		}
+		switch rec := record.Record.(type) {
+		case *pb.ExportRecord_Block:
+			last, err = importBlock(ctx, s, header, trust, last, rec.Block)
+			if err != nil {
+				return nil, err
+			}
+		case *pb.ExportRecord_State:
+			state = rec.State
+		default:
+			return nil, fmt.Errorf("%w: unexpected record %T", ErrInvalidExport, rec)
+		}
+	}
+	if last == nil || last.Height() != header.ToHeight {
+		return nil, fmt.Errorf("%w: missing blocks", ErrInvalidExport)
+	}
+
+	result := &ImportResult{FromHeight: header.FromHeight, ToHeight: header.ToHeight}
+	if state != nil {
+		var st types.State
+		if err := st.FromProto(state); err != nil {
+			return nil, err
+		}
+		if st.ChainID != header.ChainId || st.LastBlockHeight != last.Height() || !bytes.Equal(st.LastBlockID.Hash, last.Hash()) {
+			return nil, fmt.Errorf("%w: state doesn't match the last block", ErrInvalidExport)
+		}
+		if err := s.UpdateState(ctx, st); err != nil {
+			return nil, err
+		}
+		result.StateImported = true
+	}
+	s.SetHeight(ctx, last.Height())
+	return result, nil
+}
+
+// importBlock validates and saves a single exported block. Block is verified against the previous block, if any, or
+// against trust otherwise.
+func importBlock(ctx context.Context, s Store, header *pb.ExportHeader, trust ImportTrust, prev *types.Block, pbBlock *pb.ExportBlock) (*types.Block, error) {
+	block := new(types.Block)
+	if err := block.FromProto(pbBlock.Block); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExport, err)
+	}
+	commit := new(types.Commit)
+	if err := commit.FromProto(pbBlock.Commit); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExport, err)
+	}
+	height := block.Height()
+	expected := header.FromHeight
+	if prev != nil {
+		expected = prev.Height() + 1
+	}
+	if height != expected || height > header.ToHeight {
+		return nil, fmt.Errorf("%w: unexpected block at height %d (expected %d)", ErrInvalidExport, height, expected)
+	}
+	if block.SignedHeader.ChainID() != header.ChainId {
+		return nil, fmt.Errorf("%w: block at height %d has chain ID %q", ErrInvalidExport, height, block.SignedHeader.ChainID())
+	}
+	if err := block.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid block at height %d: %w", height, err)
+	}
+	if prev != nil {
+		if err := prev.SignedHeader.Verify(&block.SignedHeader); err != nil {
+			return nil, fmt.Errorf("failed to verify block at height %d: %w", height, err)
+		}
+	} else if err := verifyTrust(block, trust); err != nil {
+		return nil, err
+	}
+	// commit saved with the block is served by the node (e.g. by RPC), so it must be the commit of the block
+	equal, err := commitsEqual(commit, &block.SignedHeader.Commit)
+	if err != nil {
+		return nil, err
+	}
+	if !equal {
+		return nil, fmt.Errorf("%w: commit doesn't match the block at height %d", ErrInvalidExport, height)
+	}
+
+	if err := s.SaveBlock(ctx, block, commit); err != nil {
+		return nil, err
+	}
+	if pbBlock.Responses != nil {
+		if err := s.SaveBlockResponses(ctx, height, pbBlock.Responses); err != nil {
+			return nil, err
+		}
+	}
+	return block, nil
+}
+
+// verifyTrust checks the first imported block against trust.
+func verifyTrust(block *types.Block, trust ImportTrust) error {
+	if len(trust.ProposerAddress) > 0 && !bytes.Equal(block.SignedHeader.ProposerAddress, trust.ProposerAddress) {
+		return fmt.Errorf("%w: block at height %d is proposed by %X, expected %X", ErrUntrustedExport, block.Height(),
+			block.SignedHeader.ProposerAddress, trust.ProposerAddress)
+	}
+	if len(trust.Hash) > 0 && !bytes.Equal(block.Hash(), trust.Hash) {
+		return fmt.Errorf("%w: block at height %d has hash %s, expected %s", ErrUntrustedExport, block.Height(),
+			block.Hash(), trust.Hash)
+	}
+	return nil
+}
+
+func writeExportRecord(w io.Writer, record *pb.ExportRecord) error {
+	data, err := record.Marshal()
+	if err != nil {
+		return err
+	}
+	buf := binary.AppendUvarint(nil, uint64(len(data)))
+	buf = append(buf, data...)
+	buf = binary.BigEndian.AppendUint32(buf, crc32.Checksum(data, crcTable))
+	_, err = w.Write(buf)
+	return err
+}
+
+// readExportRecord reads a single record and verifies its checksum. io.EOF is returned only at the record boundary.
+func readExportRecord(r *bufio.Reader) (*pb.ExportRecord, error) {
+	size, err := binary.ReadUvarint(r)
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExport, err)
+	}
+	if size > maxExportRecordSize {
+		return nil, fmt.Errorf("%w: record too large: %d bytes", ErrInvalidExport, size)
+	}
+	data := make([]byte, size+4)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("%w: truncated record: %w", ErrInvalidExport, err)
+	}
+	data, checksum := data[:size], binary.BigEndian.Uint32(data[size:])
+	if crc32.Checksum(data, crcTable) != checksum {
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrInvalidExport)
+	}
+	record := new(pb.ExportRecord)
+	if err := record.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExport, err)
+	}
+	return record, nil
+}
@@ -0,0 +1,141 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestExportImport(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv)
+
+	const n = 5
+	blocks, privKey, state := saveTestChain(t, s, n)
+
+	var buf bytes.Buffer
+	require.NoError(Export(ctx, s, &buf, 1, n))
+	export := buf.Bytes()
+
+	newStore := func() Store {
+		kv, err := NewDefaultInMemoryKVStore()
+		require.NoError(err)
+		return New(kv)
+	}
+
+	trust := ImportTrust{ProposerAddress: blocks[0].SignedHeader.ProposerAddress}
+	imported := newStore()
+	result, err := Import(ctx, imported, bytes.NewReader(export), trust)
+	require.NoError(err)
+	assert.Equal(&ImportResult{FromHeight: 1, ToHeight: n, StateImported: true}, result)
+	assert.Equal(uint64(n), imported.Height())
+	for _, b := range blocks {
+		got, err := imported.GetBlock(ctx, b.Height())
+		require.NoError(err)
+		assert.Equal(b.Hash(), got.Hash())
+		responses, err := imported.GetBlockResponses(ctx, b.Height())
+		require.NoError(err)
+		assert.Equal([]byte(b.SignedHeader.AppHash), responses.AppHash)
+	}
+	importedState, err := imported.GetState(ctx)
+	require.NoError(err)
+	assert.Equal(state.LastBlockID.Hash, importedState.LastBlockID.Hash)
+
+	// import into non-empty store is refused
+	_, err = Import(ctx, imported, bytes.NewReader(export), trust)
+	assert.ErrorIs(err, ErrStoreNotEmpty)
+
+	// first block must match the trusted proposer or hash
+	_, err = Import(ctx, newStore(), bytes.NewReader(export), ImportTrust{})
+	assert.ErrorIs(err, ErrUntrustedExport)
+	_, err = Import(ctx, newStore(), bytes.NewReader(export), ImportTrust{ProposerAddress: types.GetRandomBytes(32)})
+	assert.ErrorIs(err, ErrUntrustedExport)
+	_, err = Import(ctx, newStore(), bytes.NewReader(export), ImportTrust{Hash: blocks[1].Hash()})
+	assert.ErrorIs(err, ErrUntrustedExport)
+
+	// partial export doesn't contain state
+	buf.Reset()
+	require.NoError(Export(ctx, s, &buf, 2, 3))
+	result, err = Import(ctx, newStore(), &buf, ImportTrust{Hash: blocks[1].Hash()})
+	require.NoError(err)
+	assert.Equal(&ImportResult{FromHeight: 2, ToHeight: 3}, result)
+
+	// corrupted data is detected by checksums
+	corrupted := bytes.Clone(export)
+	corrupted[len(corrupted)/2] ^= 0xff
+	_, err = Import(ctx, newStore(), bytes.NewReader(corrupted), trust)
+	assert.ErrorIs(err, ErrInvalidExport)
+
+	// truncated export is detected
+	_, err = Import(ctx, newStore(), bytes.NewReader(export[:len(export)-3]), trust)
+	assert.ErrorIs(err, ErrInvalidExport)
+
+	// broken hash links are detected
+	s2 := newStore()
+	other := types.GetRandomNextBlock(blocks[0], privKey, types.GetRandomBytes(32), 2)
+	other.SignedHeader.LastHeaderHash = types.GetRandomBytes(32)
+	commit, err := types.GetCommit(other.SignedHeader.Header, privKey)
+	require.NoError(err)
+	other.SignedHeader.Commit = *commit
+	require.NoError(s2.SaveBlock(ctx, blocks[0], &blocks[0].SignedHeader.Commit))
+	require.NoError(s2.SaveBlock(ctx, other, commit))
+	for h := uint64(1); h <= 2; h++ {
+		require.NoError(s2.SaveBlockResponses(ctx, h, &abci.ResponseFinalizeBlock{}))
+	}
+	require.NoError(s2.UpdateState(ctx, types.State{ChainID: state.ChainID, LastBlockHeight: 2}))
+	buf.Reset()
+	require.NoError(Export(ctx, s2, &buf, 1, 2))
+	_, err = Import(ctx, newStore(), &buf, trust)
+	assert.ErrorIs(err, types.ErrLastHeaderHashMismatch)
+
+	// commits not matching the blocks are detected
+	s3 := newStore()
+	require.NoError(s3.SaveBlock(ctx, blocks[0], &blocks[1].SignedHeader.Commit))
+	require.NoError(s3.SaveBlockResponses(ctx, 1, &abci.ResponseFinalizeBlock{}))
+	require.NoError(s3.UpdateState(ctx, types.State{ChainID: state.ChainID, LastBlockHeight: 1}))
+	buf.Reset()
+	require.NoError(Export(ctx, s3, &buf, 1, 1))
+	_, err = Import(ctx, newStore(), &buf, trust)
+	assert.ErrorIs(err, ErrInvalidExport)
+}
+
+// saveTestChain saves n valid blocks with block responses, and the state after the last block.
+func saveTestChain(t *testing.T, s Store, n int) ([]*types.Block, ed25519.PrivKey, types.State) {
+	t.Helper()
+	ctx := context.Background()
+
+	block, privKey := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 2})
+	var blocks []*types.Block
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			block = types.GetRandomNextBlock(block, privKey, types.GetRandomBytes(32), 2)
+		}
+		blocks = append(blocks, block)
+		require.NoError(t, s.SaveBlock(ctx, block, &block.SignedHeader.Commit))
+		require.NoError(t, s.SaveBlockResponses(ctx, block.Height(), &abci.ResponseFinalizeBlock{AppHash: block.SignedHeader.AppHash}))
+	}
+	state := types.State{
+		ChainID:         block.SignedHeader.ChainID(),
+		InitialHeight:   1,
+		LastBlockHeight: uint64(n),
+		LastBlockID:     cmtypes.BlockID{Hash: cmbytes.HexBytes(block.Hash())},
+	}
+	require.NoError(t, s.UpdateState(ctx, state))
+	return blocks, privKey, state
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"fmt"
 	"path"
 	"path/filepath"
 	"strings"
@@ -11,8 +12,20 @@ import (
 	dsq "github.com/ipfs/go-datastore/query"
 
 	badger4 "github.com/ipfs/go-ds-badger4"
+	leveldb "github.com/ipfs/go-ds-leveldb"
+	pebble "github.com/ipfs/go-ds-pebble"
 )
 
+// Supported key-value store backends.
+const (
+	BadgerBackend  = "badger"
+	PebbleBackend  = "pebble"
+	LevelDBBackend = "leveldb"
+)
+
+// copyBatchSize is the number of entries written in a single transaction by CopyKVStore.
+const copyBatchSize = 1000
+
 // NewDefaultInMemoryKVStore builds KVStore that works in-memory (without accessing disk).
 func NewDefaultInMemoryKVStore() (ds.TxnDatastore, error) {
 	inMemoryOptions := &badger4.Options{
@@ -26,8 +39,80 @@ func NewDefaultInMemoryKVStore() (ds.TxnDatastore, error) {
 
 // NewDefaultKVStore creates instance of default key-value store.
 func NewDefaultKVStore(rootDir, dbPath, dbName string) (ds.TxnDatastore, error) {
-	path := filepath.Join(rootify(rootDir, dbPath), dbName)
-	return badger4.NewDatastore(path, nil)
+	return NewKVStore(BadgerBackend, rootDir, dbPath, dbName)
+}
+
+// NewKVStore creates instance of key-value store using given backend. Empty backend means badger.
+//
+// Each backend uses separate directory, so stores of different backends can coexist (e.g. during migration).
+// Background garbage collection of badger is disabled, garbage is collected by the node with CollectGarbage.
+func NewKVStore(backend, rootDir, dbPath, dbName string) (ds.TxnDatastore, error) {
+	path := KVStorePath(backend, rootDir, dbPath, dbName)
+	switch backend {
+	case "", BadgerBackend:
+		opts := badger4.DefaultOptions
+		opts.GcInterval = 0
+		return badger4.NewDatastore(path, &opts)
+	case PebbleBackend:
+		store, err := pebble.NewDatastore(path, nil)
+		if err != nil {
+			return nil, err
+		}
+		return &batchTxnDatastore{Batching: store}, nil
+	case LevelDBBackend:
+		return leveldb.NewDatastore(path, nil)
+	default:
+		return nil, fmt.Errorf("unknown db backend: %q (supported: %s, %s, %s)", backend, BadgerBackend, PebbleBackend, LevelDBBackend)
+	}
+}
+
+// KVStorePath returns the directory of key-value store using given backend.
+//
+// Badger store uses dbName directly, for compatibility with existing nodes; other backends add a suffix.
+func KVStorePath(backend, rootDir, dbPath, dbName string) string {
+	if backend != "" && backend != BadgerBackend {
+		dbName = dbName + "-" + backend
+	}
+	return filepath.Join(rootify(rootDir, dbPath), dbName)
+}
+
+// CopyKVStore copies all entries from one key-value store to another, and returns the number of copied entries.
+//
+// Entries are written in batches, so the copy is not atomic.
+func CopyKVStore(ctx context.Context, from, to ds.TxnDatastore) (uint64, error) {
+	results, err := from.Query(ctx, dsq.Query{})
+	if err != nil {
+		return 0, err
+	}
+	defer results.Close()
+
+	var copied uint64
+	txn, err := to.NewTransaction(ctx, false)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { txn.Discard(ctx) }()
+	for result := range results.Next() {
+		if result.Error != nil {
+			return copied, result.Error
+		}
+		if err := txn.Put(ctx, ds.NewKey(result.Key), result.Value); err != nil {
+			return copied, err
+		}
+		copied++
+		if copied%copyBatchSize == 0 {
+			if err := txn.Commit(ctx); err != nil {
+				return copied, err
+			}
+			if txn, err = to.NewTransaction(ctx, false); err != nil {
+				return copied, err
+			}
+		}
+	}
+	if err := txn.Commit(ctx); err != nil {
+		return copied, err
+	}
+	return copied, to.Sync(ctx, ds.NewKey("/"))
 }
 
 // PrefixEntries retrieves all entries in the datastore whose keys have the supplied prefix
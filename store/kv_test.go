@@ -0,0 +1,210 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVStoreBackends(t *testing.T) {
+	t.Parallel()
+
+	for _, backend := range []string{BadgerBackend, PebbleBackend, LevelDBBackend} {
+		backend := backend
+		t.Run(backend, func(t *testing.T) {
+			t.Parallel()
+			assert := assert.New(t)
+			require := require.New(t)
+			ctx := context.Background()
+
+			kv, err := NewKVStore(backend, t.TempDir(), "data", "test")
+			require.NoError(err)
+			defer kv.Close() //nolint:errcheck
+
+			require.NoError(kv.Put(ctx, ds.NewKey("a"), []byte("1")))
+
+			txn, err := kv.NewTransaction(ctx, false)
+			require.NoError(err)
+			require.NoError(txn.Put(ctx, ds.NewKey("b"), []byte("2")))
+			require.NoError(txn.Delete(ctx, ds.NewKey("a")))
+			require.NoError(txn.Commit(ctx))
+
+			_, err = kv.Get(ctx, ds.NewKey("a"))
+			assert.ErrorIs(err, ds.ErrNotFound)
+			v, err := kv.Get(ctx, ds.NewKey("b"))
+			require.NoError(err)
+			assert.Equal([]byte("2"), v)
+		})
+	}
+
+	_, err := NewKVStore("unknown", t.TempDir(), "data", "test")
+	assert.Error(t, err)
+}
+
+func TestCopyKVStore(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+	rootDir := t.TempDir()
+
+	src, err := NewKVStore(BadgerBackend, rootDir, "data", "test")
+	require.NoError(err)
+	defer src.Close() //nolint:errcheck
+
+	const n = 2*copyBatchSize + 1
+	for i := 0; i < n; i++ {
+		require.NoError(src.Put(ctx, ds.NewKey(fmt.Sprintf("/%d", i)), []byte(fmt.Sprint(i))))
+	}
+
+	for _, backend := range []string{PebbleBackend, LevelDBBackend} {
+		dst, err := NewKVStore(backend, rootDir, "data", "test")
+		require.NoError(err)
+
+		copied, err := CopyKVStore(ctx, src, dst)
+		require.NoError(err)
+		assert.EqualValues(n, copied)
+
+		for _, i := range []int{0, copyBatchSize, n - 1} {
+			v, err := dst.Get(ctx, ds.NewKey(fmt.Sprintf("/%d", i)))
+			require.NoError(err)
+			assert.Equal([]byte(fmt.Sprint(i)), v)
+		}
+		require.NoError(dst.Close())
+	}
+}
+
+func TestReadOnlyKVStore(t *testing.T) {
+	t.Parallel()
+
+	for _, backend := range []string{BadgerBackend, PebbleBackend, LevelDBBackend} {
+		backend := backend
+		t.Run(backend, func(t *testing.T) {
+			t.Parallel()
+			assert := assert.New(t)
+			require := require.New(t)
+			ctx := context.Background()
+			rootDir := t.TempDir()
+
+			_, err := NewReadOnlyKVStore(backend, rootDir, "data", "test")
+			assert.Error(err, "read-only store must not be created")
+
+			kv, err := NewKVStore(backend, rootDir, "data", "test")
+			require.NoError(err)
+			require.NoError(kv.Put(ctx, ds.NewKey("a"), []byte("1")))
+			require.NoError(kv.Close())
+
+			ro, err := NewReadOnlyKVStore(backend, rootDir, "data", "test")
+			require.NoError(err)
+			defer ro.Close() //nolint:errcheck
+
+			v, err := ro.Get(ctx, ds.NewKey("a"))
+			require.NoError(err)
+			assert.Equal([]byte("1"), v)
+			assert.ErrorIs(ro.Put(ctx, ds.NewKey("b"), []byte("2")), ErrReadOnly)
+			assert.ErrorIs(ro.Delete(ctx, ds.NewKey("a")), ErrReadOnly)
+
+			txn, err := ro.NewTransaction(ctx, false)
+			require.NoError(err)
+			assert.ErrorIs(txn.Put(ctx, ds.NewKey("b"), []byte("2")), ErrReadOnly)
+			v, err = txn.Get(ctx, ds.NewKey("a"))
+			require.NoError(err)
+			assert.Equal([]byte("1"), v)
+			txn.Discard(ctx)
+
+			refreshed, err := ro.Refresh()
+			require.NoError(err)
+			assert.False(refreshed)
+			v, err = ro.Get(ctx, ds.NewKey("a"))
+			require.NoError(err)
+			assert.Equal([]byte("1"), v)
+		})
+	}
+}
+
+func TestReadOnlyKVStoreRefresh(t *testing.T) {
+	t.Parallel()
+
+	for _, exclusive := range []bool{false, true} {
+		exclusive := exclusive
+		t.Run(fmt.Sprint("exclusive=", exclusive), func(t *testing.T) {
+			t.Parallel()
+			assert := assert.New(t)
+			require := require.New(t)
+			ctx := context.Background()
+
+			// every reopen returns a new snapshot of the data, with one entry for every version
+			var version atomic.Int64
+			var opened []ds.Datastore
+			ro, err := newReadOnlyKVStore(func() (ds.Datastore, error) {
+				kv, err := NewDefaultInMemoryKVStore()
+				if err != nil {
+					return nil, err
+				}
+				for i := int64(0); i <= version.Load(); i++ {
+					if err := kv.Put(ctx, ds.NewKey(fmt.Sprint(i)), []byte(fmt.Sprint(i))); err != nil {
+						return nil, err
+					}
+				}
+				opened = append(opened, kv)
+				return kv, nil
+			}, func() (snapshotVersion, error) {
+				return snapshotVersion{modTime: version.Load()}, nil
+			}, exclusive)
+			require.NoError(err)
+			defer ro.Close() //nolint:errcheck
+
+			_, err = ro.Get(ctx, ds.NewKey("1"))
+			assert.ErrorIs(err, ds.ErrNotFound)
+
+			// store is reopened only if the snapshot has changed
+			refreshed, err := ro.Refresh()
+			require.NoError(err)
+			assert.False(refreshed)
+			assert.Len(opened, 1)
+
+			results, err := PrefixEntries(ctx, ro, "/")
+			require.NoError(err)
+			version.Add(1)
+			if exclusive {
+				// store is closed after open results are closed
+				done := make(chan error)
+				go func() {
+					_, err := ro.Refresh()
+					done <- err
+				}()
+				entries, err := results.Rest()
+				require.NoError(err)
+				assert.Len(entries, 1)
+				require.NoError(<-done)
+			} else {
+				// results are read from the old snapshot
+				refreshed, err := ro.Refresh()
+				require.NoError(err)
+				assert.True(refreshed)
+				entries, err := results.Rest()
+				require.NoError(err)
+				assert.Len(entries, 1)
+				ro.closing.Wait()
+			}
+			assert.Len(opened, 2)
+			_, err = opened[0].Get(ctx, ds.NewKey("0"))
+			assert.Error(err, "old snapshot must be closed")
+
+			v, err := ro.Get(ctx, ds.NewKey("1"))
+			require.NoError(err)
+			assert.Equal([]byte("1"), v)
+			results, err = PrefixEntries(ctx, ro, "/")
+			require.NoError(err)
+			entries, err := results.Rest()
+			require.NoError(err)
+			assert.Len(entries, 2)
+		})
+	}
+}
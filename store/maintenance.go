@@ -0,0 +1,179 @@
+package store
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/dgraph-io/badger/v4"
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+	badger4 "github.com/ipfs/go-ds-badger4"
+	leveldb "github.com/ipfs/go-ds-leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Components of the node reported in Stats.
+const (
+	ComponentMain        = "main"
+	ComponentHeaderStore = "header_store"
+	ComponentBlockStore  = "block_store"
+	ComponentDALC        = "dalc"
+	ComponentIndexer     = "indexer"
+	ComponentEvidence    = "evidence"
+	ComponentOther       = "other"
+)
+
+// components are all the components of the node reported in Stats.
+var components = []string{
+	ComponentMain, ComponentHeaderStore, ComponentBlockStore, ComponentDALC, ComponentIndexer, ComponentEvidence,
+	ComponentOther,
+}
+
+// componentPrefixes maps prefixes of the key-value stores of the node (see node.mainPrefix, node.dalcPrefix,
+// node.indexerPrefix and node.evidencePrefix) to components of the node.
+var componentPrefixes = map[string]string{
+	"0": ComponentMain,
+	"1": ComponentDALC,
+	"2": ComponentIndexer,
+	"3": ComponentEvidence,
+}
+
+// componentNamespaces maps top-level namespaces of the key-value stores of the node to components of the node.
+var componentNamespaces = map[string]string{
+	blockPrefix:          ComponentMain,
+	indexPrefix:          ComponentMain,
+	commitPrefix:         ComponentMain,
+	extendedCommitPrefix: ComponentMain,
+	statePrefix:          ComponentMain,
+	responsesPrefix:      ComponentMain,
+	metaPrefix:           ComponentMain,
+	proofPrefix:          ComponentMain,
+	paramsPrefix:         ComponentMain,
+	timePrefix:           ComponentMain,
+	validatorsPrefix:     ComponentMain,
+	// see block.NewHeaderSyncService and block.NewBlockSyncService
+	"headerSync": ComponentHeaderStore,
+	"blockSync":  ComponentBlockStore,
+	// see evidence.Pool
+	"evidence": ComponentEvidence,
+}
+
+// ComponentStats describes the data of a single component of the node in the key-value store.
+type ComponentStats struct {
+	// Keys is the number of keys.
+	Keys uint64
+	// Size is the total size of keys and values in bytes.
+	Size uint64
+}
+
+// Stats describes the usage of the key-value store.
+type Stats struct {
+	// DiskUsage is the size of the key-value store on disk in bytes, 0 if it's not reported by the backend.
+	DiskUsage uint64
+	// Components contains stats of all the components of the node, by component name.
+	Components map[string]ComponentStats
+}
+
+// DiskUsage returns the size of the key-value store on disk in bytes, or 0 if it's not reported by the backend.
+func DiskUsage(ctx context.Context, kv ds.Datastore) (uint64, error) {
+	return ds.DiskUsage(ctx, kv)
+}
+
+// GetStats returns the disk usage of the key-value store and the size of the data of each component of the node.
+//
+// All the keys of the store are iterated, so it takes time proportional to the number of keys. Values are not read
+// by backends that keep value sizes along with keys.
+func GetStats(ctx context.Context, kv ds.Datastore) (*Stats, error) {
+	diskUsage, err := DiskUsage(ctx, kv)
+	if err != nil {
+		return nil, err
+	}
+	stats := &Stats{
+		DiskUsage:  diskUsage,
+		Components: make(map[string]ComponentStats, len(components)),
+	}
+	for _, component := range components {
+		stats.Components[component] = ComponentStats{}
+	}
+	results, err := kv.Query(ctx, dsq.Query{KeysOnly: true, ReturnsSizes: true})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		component := keyComponent(result.Key)
+		c := stats.Components[component]
+		c.Keys++
+		c.Size += uint64(len(result.Key))
+		if result.Size > 0 {
+			c.Size += uint64(result.Size)
+		}
+		stats.Components[component] = c
+	}
+	return stats, ctx.Err()
+}
+
+// keyComponent returns the component of the node owning given key of the base key-value store of the node.
+//
+// Components of the node use key-value stores created with node prefixes, sharing the key space of the base key-value
+// store. If the key starts with a node prefix, the component is recognized by the prefix (with sync services under
+// the main prefix). node.newPrefixKV returns the base key-value store itself, so keys written by the node don't have
+// the prefixes, and components are recognized by namespaces of their keys.
+func keyComponent(key string) string {
+	prefix, rest, _ := strings.Cut(strings.TrimPrefix(key, "/"), "/")
+	if component, ok := componentPrefixes[prefix]; ok {
+		if component == ComponentMain {
+			namespace, _, _ := strings.Cut(rest, "/")
+			if c := componentNamespaces[namespace]; c == ComponentHeaderStore || c == ComponentBlockStore {
+				return c
+			}
+		}
+		return component
+	}
+	return namespaceComponent(strings.TrimPrefix(key, "/"))
+}
+
+// namespaceComponent returns the component of the node owning given key (without leading slash) by its namespace.
+func namespaceComponent(key string) string {
+	namespace, _, _ := strings.Cut(key, "/")
+	if component, ok := componentNamespaces[namespace]; ok {
+		return component
+	}
+	// indexers use transaction hashes and event keys (e.g. "tx.height") as namespaces
+	if strings.Contains(namespace, ".") {
+		return ComponentIndexer
+	}
+	if hash, err := hex.DecodeString(namespace); err == nil && len(hash) == 32 {
+		return ComponentIndexer
+	}
+	return ComponentOther
+}
+
+// CollectGarbage reclaims disk space used by deleted and overwritten data of the key-value store.
+//
+// Badger value log files with at least discardRatio of stale data are rewritten, until there are no such files.
+// LevelDB store is fully compacted. Pebble reclaims space during its background compactions, so there's nothing to do.
+func CollectGarbage(ctx context.Context, kv ds.Datastore, discardRatio float64) error {
+	switch store := kv.(type) {
+	case *badger4.Datastore:
+		for ctx.Err() == nil {
+			err := store.DB.RunValueLogGC(discardRatio)
+			if errors.Is(err, badger.ErrNoRewrite) || errors.Is(err, badger.ErrGCInMemoryMode) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return ctx.Err()
+	case *leveldb.Datastore:
+		return store.DB.CompactRange(util.Range{})
+	default:
+		return nil
+	}
+}
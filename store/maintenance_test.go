@@ -0,0 +1,129 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	ktds "github.com/ipfs/go-datastore/keytransform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyComponent(t *testing.T) {
+	t.Parallel()
+
+	evidenceHash := strings.Repeat("AB", 32)
+	cases := []struct {
+		key       string
+		component string
+	}{
+		// keys with node prefixes
+		{"/0/b/0a1b", ComponentMain},
+		{"/0/s", ComponentMain},
+		{"/0/i/00000000000000000000/00000000000000000012", ComponentMain},
+		{"/0/m/schema-version", ComponentMain},
+		{"/0/headerSync/head", ComponentHeaderStore},
+		{"/0/blockSync/12", ComponentBlockStore},
+		{"/1/abcd", ComponentDALC},
+		{"/2/tx.height/5/5/0", ComponentIndexer},
+		{"/2/block_events/block.height/5", ComponentIndexer},
+		{"/3/evidence/pending/" + evidenceHash, ComponentEvidence},
+		{"/4/abcd", ComponentOther},
+		// keys written by the node, without node prefixes
+		{"/b/0a1b", ComponentMain},
+		{"/s", ComponentMain},
+		{"/cp/12", ComponentMain},
+		{"/p/12", ComponentMain},
+		{"/c/" + evidenceHash, ComponentMain},
+		{"/m/schema-version", ComponentMain},
+		{"/headerSync/head", ComponentHeaderStore},
+		{"/blockSync/12", ComponentBlockStore},
+		{"/tx.height/5/5/0", ComponentIndexer},
+		{"/block.height/5", ComponentIndexer},
+		{"/" + fmt.Sprintf("%064x", 1), ComponentIndexer},
+		{"/evidence/pending/" + evidenceHash, ComponentEvidence},
+		{"/evidence/committed/" + evidenceHash, ComponentEvidence},
+		{"/peers/addrs", ComponentOther},
+		{"/abcd", ComponentOther},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.component, keyComponent(c.key), c.key)
+	}
+}
+
+func TestGetStatsAndCollectGarbage(t *testing.T) {
+	t.Parallel()
+
+	for _, backend := range []string{BadgerBackend, PebbleBackend, LevelDBBackend} {
+		backend := backend
+		t.Run(backend, func(t *testing.T) {
+			t.Parallel()
+			assert := assert.New(t)
+			require := require.New(t)
+			ctx := context.Background()
+
+			kv, err := NewKVStore(backend, t.TempDir(), "data", "test")
+			require.NoError(err)
+			defer kv.Close() //nolint:errcheck
+
+			// key-value stores are created as in node.NewNode
+			mainKV := newPrefixKV(kv, "0")
+			value := make([]byte, 100)
+			for i := 0; i < 10; i++ {
+				require.NoError(mainKV.Put(ctx, ds.NewKey(getResponsesKey(uint64(i))), value))
+			}
+			// sync services use go-header stores with prefix
+			headerKV := ktds.Wrap(mainKV, ktds.PrefixTransform{Prefix: ds.NewKey("headerSync")})
+			require.NoError(headerKV.Put(ctx, ds.NewKey("/1"), value))
+			indexerKV := newPrefixKV(newPrefixKV(kv, "2"), "block_events")
+			require.NoError(indexerKV.Put(ctx, ds.NewKey("/block.height/1"), value))
+			evidenceKey := "/evidence/pending/" + strings.Repeat("AB", 32)
+			require.NoError(newPrefixKV(kv, "3").Put(ctx, ds.NewKey(evidenceKey), value))
+			require.NoError(kv.Put(ctx, ds.NewKey("/peers/1"), value))
+			// keys with node prefixes are recognized as well
+			dalcKV := ktds.Wrap(kv, ktds.PrefixTransform{Prefix: ds.NewKey("1")})
+			require.NoError(dalcKV.Put(ctx, ds.NewKey("/1"), value))
+			require.NoError(kv.Sync(ctx, ds.NewKey("/")))
+
+			stats, err := GetStats(ctx, kv)
+			require.NoError(err)
+			assert.Len(stats.Components, len(components))
+			assert.Equal(ComponentStats{Keys: 10, Size: uint64(10*100 + 10*len("/r/0"))}, stats.Components[ComponentMain])
+			assert.Equal(ComponentStats{Keys: 1, Size: 100 + uint64(len("/headerSync/1"))}, stats.Components[ComponentHeaderStore])
+			assert.Equal(ComponentStats{}, stats.Components[ComponentBlockStore])
+			assert.Equal(ComponentStats{Keys: 1, Size: 100 + uint64(len("/1/1"))}, stats.Components[ComponentDALC])
+			assert.Equal(ComponentStats{Keys: 1, Size: 100 + uint64(len("/block.height/1"))}, stats.Components[ComponentIndexer])
+			assert.Equal(ComponentStats{Keys: 1, Size: 100 + uint64(len(evidenceKey))}, stats.Components[ComponentEvidence])
+			assert.Equal(ComponentStats{Keys: 1, Size: 100 + uint64(len("/peers/1"))}, stats.Components[ComponentOther])
+
+			for i := 0; i < 10; i++ {
+				require.NoError(mainKV.Delete(ctx, ds.NewKey(getResponsesKey(uint64(i)))))
+			}
+			require.NoError(CollectGarbage(ctx, kv, 0.5))
+
+			stats, err = GetStats(ctx, kv)
+			require.NoError(err)
+			assert.Equal(ComponentStats{}, stats.Components[ComponentMain])
+		})
+	}
+}
+
+// newPrefixKV returns a key-value store with given prefix exactly like node.newPrefixKV. The wrapped store is returned,
+// so keys are not prefixed.
+func newPrefixKV(kv ds.Datastore, prefix string) ds.TxnDatastore {
+	return ktds.Wrap(kv, ktds.PrefixTransform{Prefix: ds.NewKey(prefix)}).Children()[0].(ds.TxnDatastore)
+}
+
+func TestCollectGarbageInMemory(t *testing.T) {
+	t.Parallel()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	defer kv.Close() //nolint:errcheck
+
+	require.NoError(t, kv.Put(context.Background(), ds.NewKey("/b/1"), []byte("1")))
+	assert.NoError(t, CollectGarbage(context.Background(), kv, 0.5))
+}
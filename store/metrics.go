@@ -0,0 +1,101 @@
+package store
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "store"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of store reads served from cache, by type of data.
+	CacheHits metrics.Counter
+	// Number of store reads not found in cache, by type of data.
+	CacheMisses metrics.Counter
+	// Size of the key-value store on disk.
+	DiskUsage metrics.Gauge
+	// Total size of keys and values in the key-value store, by component of the node.
+	DataSize metrics.Gauge
+	// Number of keys in the key-value store, by component of the node.
+	DataKeys metrics.Gauge
+	// Number of garbage collection runs of the key-value store.
+	GCRuns metrics.Counter
+	// Duration of the last garbage collection run of the key-value store.
+	GCDuration metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	cacheLabels := append(labels, "type")
+	componentLabels := append(labels[:len(labels):len(labels)], "component")
+	return &Metrics{
+		CacheHits: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_hits",
+			Help:      "Number of store reads served from cache, by type of data.",
+		}, cacheLabels).With(labelsAndValues...),
+		CacheMisses: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_misses",
+			Help:      "Number of store reads not found in cache, by type of data.",
+		}, cacheLabels).With(labelsAndValues...),
+		DiskUsage: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "disk_usage_bytes",
+			Help:      "Size of the key-value store on disk.",
+		}, labels).With(labelsAndValues...),
+		DataSize: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "data_size_bytes",
+			Help:      "Total size of keys and values in the key-value store, by component of the node.",
+		}, componentLabels).With(labelsAndValues...),
+		DataKeys: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "data_keys",
+			Help:      "Number of keys in the key-value store, by component of the node.",
+		}, componentLabels).With(labelsAndValues...),
+		GCRuns: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "gc_runs",
+			Help:      "Number of garbage collection runs of the key-value store.",
+		}, labels).With(labelsAndValues...),
+		GCDuration: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "gc_duration_seconds",
+			Help:      "Duration of the last garbage collection run of the key-value store.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		CacheHits:   discard.NewCounter(),
+		CacheMisses: discard.NewCounter(),
+		DiskUsage:   discard.NewGauge(),
+		DataSize:    discard.NewGauge(),
+		DataKeys:    discard.NewGauge(),
+		GCRuns:      discard.NewCounter(),
+		GCDuration:  discard.NewGauge(),
+	}
+}
@@ -0,0 +1,300 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// SchemaVersion is the version of the store schema (key layout and data encoding) used by this version of rollkit.
+//
+// It has to be incremented whenever a new migration is added.
+const SchemaVersion uint64 = 4
+
+// schemaVersionKey is the metadata key used to save schema version of the store.
+const schemaVersionKey = "schema-version"
+
+// ErrUnknownSchemaVersion is returned when the store was created by a newer version of rollkit.
+var ErrUnknownSchemaVersion = errors.New("unknown store schema version")
+
+// Migration upgrades the store from schema version Version-1 to Version.
+//
+// Migrations must be idempotent, as the node can be stopped after the migration was applied but before the schema
+// version was saved.
+type Migration struct {
+	Version     uint64
+	Description string
+	Migrate     func(ctx context.Context, s Store) error
+}
+
+// migrations is the registry of all store migrations, ordered by version.
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "save consensus params of the current state for historical queries",
+		Migrate:     migrateConsensusParams,
+	},
+	{
+		Version:     2,
+		Description: "save validator sets of saved blocks for historical queries",
+		Migrate:     migrateValidators,
+	},
+	{
+		Version:     3,
+		Description: "rewrite block height index with keys ordered by height for range scans",
+		Migrate:     migrateIndexKeys,
+	},
+	{
+		Version:     4,
+		Description: "rewrite consensus params and validator set keys ordered by height for lookups of the last change",
+		Migrate:     migrateChangeKeys,
+	},
+}
+
+// migrationBatchSize is the number of entries written at once by migrations rewriting many entries.
+const migrationBatchSize = 1000
+
+// GetSchemaVersion returns schema version of the store.
+//
+// Stores created before versioning was introduced have version 0. Empty stores have the current SchemaVersion.
+func GetSchemaVersion(ctx context.Context, s Store) (uint64, error) {
+	data, err := s.GetMetadata(ctx, schemaVersionKey)
+	if err == nil {
+		if len(data) != 8 {
+			return 0, fmt.Errorf("invalid schema version length: %d", len(data))
+		}
+		return binary.BigEndian.Uint64(data), nil
+	}
+	if !errors.Is(err, ds.ErrNotFound) {
+		return 0, err
+	}
+	_, err = s.GetState(ctx)
+	if errors.Is(err, ds.ErrNotFound) {
+		return SchemaVersion, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return 0, nil
+}
+
+// Migrate upgrades the store to the current SchemaVersion by applying all pending migrations in order.
+//
+// Migrations that were (or, with dryRun, would be) applied are returned. Stores with schema version newer than
+// SchemaVersion are refused with ErrUnknownSchemaVersion.
+func Migrate(ctx context.Context, s Store, dryRun bool) ([]Migration, error) {
+	return migrate(ctx, s, migrations, SchemaVersion, dryRun)
+}
+
+func migrate(ctx context.Context, s Store, registry []Migration, target uint64, dryRun bool) ([]Migration, error) {
+	version, err := GetSchemaVersion(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store schema version: %w", err)
+	}
+	if version > target {
+		return nil, fmt.Errorf("%w: %d (supported: %d), upgrade rollkit", ErrUnknownSchemaVersion, version, target)
+	}
+
+	var pending []Migration
+	for _, m := range registry {
+		if m.Version > version && m.Version <= target {
+			pending = append(pending, m)
+		}
+	}
+	if dryRun {
+		return pending, nil
+	}
+
+	var applied []Migration
+	for _, m := range pending {
+		if err := m.Migrate(ctx, s); err != nil {
+			return applied, fmt.Errorf("store migration to schema version %d failed: %w", m.Version, err)
+		}
+		if err := setSchemaVersion(ctx, s, m.Version); err != nil {
+			return applied, err
+		}
+		applied = append(applied, m)
+	}
+	// version is saved also when there were no migrations, e.g. for new stores
+	return applied, setSchemaVersion(ctx, s, target)
+}
+
+func setSchemaVersion(ctx context.Context, s Store, version uint64) error {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, version)
+	return s.SetMetadata(ctx, schemaVersionKey, data)
+}
+
+// migrateConsensusParams saves consensus params of the current state, so they're available via GetConsensusParams.
+func migrateConsensusParams(ctx context.Context, s Store) error {
+	state, err := s.GetState(ctx)
+	if err != nil {
+		return err
+	}
+	return s.UpdateState(ctx, state)
+}
+
+// migrateValidators saves validator sets of all the blocks up to the last block of the state, so they're available via
+// GetValidators.
+func migrateValidators(ctx context.Context, s Store) error {
+	store, ok := s.(*DefaultStore)
+	if !ok {
+		return fmt.Errorf("unsupported store type: %T", s)
+	}
+	state, err := s.GetState(ctx)
+	if err != nil {
+		return err
+	}
+	from := state.InitialHeight
+	if from == 0 {
+		from = 1
+	}
+	// index entries have legacy keys until migrateIndexKeys
+	for height := from; height <= state.LastBlockHeight; height++ {
+		hash, err := loadHashFromLegacyIndex(ctx, store.db, height)
+		if err != nil {
+			return err
+		}
+		header, err := getHeader(ctx, store.db, hash)
+		if err != nil {
+			return err
+		}
+		if err := store.saveValidators(ctx, store.db, height, header.Validators); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadHashFromLegacyIndex returns the hash of a block given its height, from the index entry with legacy key.
+func loadHashFromLegacyIndex(ctx context.Context, r ds.Read, height uint64) (types.Hash, error) {
+	blob, err := r.Get(ctx, ds.NewKey(getLegacyIndexKey(height)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load block hash for height %v: %w", height, err)
+	}
+	if len(blob) != 32 {
+		return nil, errors.New("invalid hash length")
+	}
+	return blob, nil
+}
+
+// migrateIndexKeys rewrites the height to hash index entries with legacy keys (decimal heights) using keys ordered by
+// height, see getIndexKey.
+func migrateIndexKeys(ctx context.Context, s Store) error {
+	store, ok := s.(*DefaultStore)
+	if !ok {
+		return fmt.Errorf("unsupported store type: %T", s)
+	}
+	results, err := store.db.Query(ctx, dsq.Query{Prefix: GenerateKey([]string{indexPrefix})})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+
+	batch := make([]dsq.Entry, 0, migrationBatchSize)
+	for result := range results.Next() {
+		if result.Error != nil {
+			return result.Error
+		}
+		// migrated entries have the bucket in the key
+		if len(ds.RawKey(result.Key).Namespaces()) != 2 {
+			continue
+		}
+		batch = append(batch, dsq.Entry{Key: result.Key, Value: bytes.Clone(result.Value)})
+		if len(batch) == migrationBatchSize {
+			if err := rewriteIndexEntries(ctx, store.db, batch); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+	}
+	return rewriteIndexEntries(ctx, store.db, batch)
+}
+
+// rewriteIndexEntries saves given index entries with legacy keys using current keys, and deletes the legacy keys.
+func rewriteIndexEntries(ctx context.Context, db ds.TxnDatastore, entries []dsq.Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	txn, err := db.NewTransaction(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+	for _, entry := range entries {
+		key := ds.RawKey(entry.Key)
+		height, err := strconv.ParseUint(key.Name(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid index key %s: %w", key, err)
+		}
+		if err := txn.Put(ctx, ds.NewKey(getIndexKey(height)), entry.Value); err != nil {
+			return err
+		}
+		if err := txn.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return txn.Commit(ctx)
+}
+
+// migrateChangeKeys rewrites the consensus params and validator sets saved with legacy keys (decimal heights) using
+// keys ordered from the highest height, see getChangeKey.
+//
+// They're saved only when they change, so there are few of them, and all of them are rewritten in a single transaction.
+func migrateChangeKeys(ctx context.Context, s Store) error {
+	store, ok := s.(*DefaultStore)
+	if !ok {
+		return fmt.Errorf("unsupported store type: %T", s)
+	}
+	txn, err := store.db.NewTransaction(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+	for _, prefix := range []string{paramsPrefix, validatorsPrefix} {
+		if err := rewriteChangeEntries(ctx, store.db, txn, prefix); err != nil {
+			return err
+		}
+	}
+	return txn.Commit(ctx)
+}
+
+// rewriteChangeEntries saves the entries with given prefix and legacy keys using current keys, and deletes the legacy
+// keys.
+func rewriteChangeEntries(ctx context.Context, r ds.Read, w ds.Write, prefix string) error {
+	results, err := r.Query(ctx, dsq.Query{Prefix: GenerateKey([]string{prefix})})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			return result.Error
+		}
+		key := ds.RawKey(result.Key)
+		// migrated entries have zero-padded heights
+		if _, err := parseChangeKey(key); err == nil {
+			continue
+		}
+		height, err := strconv.ParseUint(key.Name(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid key %s: %w", key, err)
+		}
+		if err := w.Put(ctx, ds.NewKey(getChangeKey(prefix, height)), bytes.Clone(result.Value)); err != nil {
+			return err
+		}
+		if err := w.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
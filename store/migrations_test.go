@@ -0,0 +1,219 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv)
+
+	// new store has current version
+	version, err := GetSchemaVersion(ctx, s)
+	require.NoError(err)
+	assert.Equal(SchemaVersion, version)
+
+	// state saved without consensus params simulates store created before versioning
+	state := types.State{
+		LastHeightConsensusParamsChanged: 1,
+		ConsensusParams:                  cmproto.ConsensusParams{Block: &cmproto.BlockParams{MaxBytes: 100}},
+	}
+	pbState, err := state.ToProto()
+	require.NoError(err)
+	data, err := pbState.Marshal()
+	require.NoError(err)
+	require.NoError(kv.Put(ctx, ds.NewKey(getStateKey()), data))
+
+	version, err = GetSchemaVersion(ctx, s)
+	require.NoError(err)
+	assert.Equal(uint64(0), version)
+
+	pending, err := Migrate(ctx, s, true)
+	require.NoError(err)
+	require.Len(pending, len(migrations))
+	version, err = GetSchemaVersion(ctx, s)
+	require.NoError(err)
+	assert.Equal(uint64(0), version)
+	_, err = s.GetConsensusParams(ctx, 1)
+	assert.ErrorIs(err, ds.ErrNotFound)
+
+	applied, err := Migrate(ctx, s, false)
+	require.NoError(err)
+	assert.Len(applied, len(pending))
+	version, err = GetSchemaVersion(ctx, s)
+	require.NoError(err)
+	assert.Equal(SchemaVersion, version)
+	params, err := s.GetConsensusParams(ctx, 1)
+	require.NoError(err)
+	assert.Equal(int64(100), params.Block.MaxBytes)
+
+	applied, err = Migrate(ctx, s, false)
+	require.NoError(err)
+	assert.Empty(applied)
+
+	// store from the future is refused
+	require.NoError(setSchemaVersion(ctx, s, SchemaVersion+1))
+	_, err = Migrate(ctx, s, true)
+	assert.ErrorIs(err, ErrUnknownSchemaVersion)
+	_, err = Migrate(ctx, s, false)
+	assert.ErrorIs(err, ErrUnknownSchemaVersion)
+}
+
+func TestMigrateOrder(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv)
+	require.NoError(setSchemaVersion(ctx, s, 1))
+
+	var order []uint64
+	record := func(version uint64) func(context.Context, Store) error {
+		return func(ctx context.Context, s Store) error {
+			current, err := GetSchemaVersion(ctx, s)
+			require.NoError(err)
+			assert.Equal(version-1, current)
+			order = append(order, version)
+			return nil
+		}
+	}
+	registry := []Migration{
+		{Version: 1, Migrate: record(1)},
+		{Version: 2, Migrate: record(2)},
+		{Version: 3, Migrate: record(3)},
+		{Version: 4, Migrate: record(4)},
+	}
+
+	applied, err := migrate(ctx, s, registry, 3, false)
+	require.NoError(err)
+	assert.Len(applied, 2)
+	assert.Equal([]uint64{2, 3}, order)
+
+	version, err := GetSchemaVersion(ctx, s)
+	require.NoError(err)
+	assert.Equal(uint64(3), version)
+}
+
+func TestMigrateIndexKeys(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv)
+	const n = 5
+	blocks, _, _ := saveTestChain(t, s, n)
+	useLegacyIndex(t, kv, n)
+
+	_, err = s.GetBlocks(ctx, 1, n)
+	assert.ErrorIs(err, ds.ErrNotFound)
+
+	// migration is idempotent
+	for i := 0; i < 2; i++ {
+		require.NoError(migrateIndexKeys(ctx, s))
+		got, err := s.GetBlocks(ctx, 1, n)
+		require.NoError(err)
+		require.Len(got, n)
+		for j, b := range blocks {
+			assert.Equal(b.Hash(), got[j].Hash())
+		}
+		for h := uint64(1); h <= n; h++ {
+			has, err := kv.Has(ctx, ds.NewKey(getLegacyIndexKey(h)))
+			require.NoError(err)
+			assert.False(has)
+		}
+	}
+}
+
+// useLegacyIndex moves the height to hash index entries of heights 1 to n to legacy keys, to simulate a store created
+// before schema version 3.
+func useLegacyIndex(t *testing.T, kv ds.Datastore, n uint64) {
+	t.Helper()
+	ctx := context.Background()
+	for h := uint64(1); h <= n; h++ {
+		hash, err := kv.Get(ctx, ds.NewKey(getIndexKey(h)))
+		require.NoError(t, err)
+		require.NoError(t, kv.Put(ctx, ds.NewKey(getLegacyIndexKey(h)), hash))
+		require.NoError(t, kv.Delete(ctx, ds.NewKey(getIndexKey(h))))
+	}
+}
+
+func TestMigrateChangeKeys(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv)
+	// validator set changes at height 1 and params at heights 1, 5 and 12, so decimal keys aren't ordered by height
+	_, _, state := saveTestChain(t, s, 3)
+	for _, c := range []struct {
+		height   uint64
+		maxBytes int64
+	}{{1, 100}, {5, 200}, {12, 300}} {
+		state.LastHeightConsensusParamsChanged = c.height
+		state.ConsensusParams = cmproto.ConsensusParams{Block: &cmproto.BlockParams{MaxBytes: c.maxBytes}}
+		require.NoError(s.UpdateState(ctx, state))
+	}
+	validators, err := s.GetValidators(ctx, 3)
+	require.NoError(err)
+	useLegacyChangeKeys(t, kv, paramsPrefix, 1, 5, 12)
+	useLegacyChangeKeys(t, kv, validatorsPrefix, 1)
+
+	// migration is idempotent
+	for i := 0; i < 2; i++ {
+		require.NoError(migrateChangeKeys(ctx, s))
+		params, err := s.GetConsensusParams(ctx, 11)
+		require.NoError(err)
+		assert.Equal(int64(200), params.Block.MaxBytes)
+		params, err = s.GetConsensusParams(ctx, 100)
+		require.NoError(err)
+		assert.Equal(int64(300), params.Block.MaxBytes)
+		got, err := s.GetValidators(ctx, 3)
+		require.NoError(err)
+		assert.Equal(validators.Hash(), got.Hash())
+		for _, h := range []uint64{5, 12} {
+			has, err := kv.Has(ctx, ds.NewKey(getLegacyChangeKey(paramsPrefix, h)))
+			require.NoError(err)
+			assert.False(has)
+		}
+	}
+}
+
+// useLegacyChangeKeys moves the entries saved with given prefix at given heights to legacy keys, to simulate a store
+// created before schema version 4.
+func useLegacyChangeKeys(t *testing.T, kv ds.Datastore, prefix string, heights ...uint64) {
+	t.Helper()
+	ctx := context.Background()
+	for _, h := range heights {
+		value, err := kv.Get(ctx, ds.NewKey(getChangeKey(prefix, h)))
+		require.NoError(t, err)
+		require.NoError(t, kv.Delete(ctx, ds.NewKey(getChangeKey(prefix, h))))
+		require.NoError(t, kv.Put(ctx, ds.NewKey(getLegacyChangeKey(prefix, h)), value))
+	}
+}
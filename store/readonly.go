@@ -0,0 +1,334 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+	badger4 "github.com/ipfs/go-ds-badger4"
+	leveldb "github.com/ipfs/go-ds-leveldb"
+	pebbleds "github.com/ipfs/go-ds-pebble"
+)
+
+// ErrReadOnly is returned on every write to a read-only key-value store.
+var ErrReadOnly = errors.New("key-value store is read-only")
+
+var errNotOpen = errors.New("key-value store is not open")
+
+// ReadOnlyKVStore is a read-only key-value store, used to serve data written by another node.
+//
+// Writes fail with ErrReadOnly, without reaching the underlying store. Transactions are supported only for reading;
+// their reads are served directly from the store.
+type ReadOnlyKVStore struct {
+	mtx     sync.RWMutex
+	current *readOnlyHandle
+
+	// refreshMtx serializes Refresh and Close
+	refreshMtx sync.Mutex
+	// closing tracks replaced snapshots waiting for their reads to finish
+	closing sync.WaitGroup
+	open    func() (ds.Datastore, error)
+	version func() (snapshotVersion, error)
+	// exclusive is set if the store can't be opened twice in a single process
+	exclusive bool
+}
+
+// readOnlyHandle is an opened underlying store, closed after all the reads using it are finished.
+type readOnlyHandle struct {
+	kv      ds.Datastore
+	version snapshotVersion
+	reads   sync.WaitGroup
+}
+
+// snapshotVersion identifies the contents of a store directory: the number and the total size of the files, and the
+// last modification time.
+type snapshotVersion struct {
+	files   int
+	size    int64
+	modTime int64
+}
+
+var (
+	_ ds.TxnDatastore = &ReadOnlyKVStore{}
+	_ ds.Batching     = &ReadOnlyKVStore{}
+)
+
+// NewReadOnlyKVStore opens existing key-value store using given backend in read-only mode. Empty backend means badger.
+//
+// All the backends lock the store directory, so it can't be opened while it's used by a running node, and the store
+// must not be modified while it's open. A read-only store serves a snapshot of the data: the directory of a stopped
+// node, or a copy of the directory of a running node (e.g. a file system snapshot). Refresh reopens the store after
+// the snapshot is replaced.
+func NewReadOnlyKVStore(backend, rootDir, dbPath, dbName string) (*ReadOnlyKVStore, error) {
+	path := KVStorePath(backend, rootDir, dbPath, dbName)
+	return newReadOnlyKVStore(func() (ds.Datastore, error) {
+		return openReadOnly(backend, path)
+	}, func() (snapshotVersion, error) {
+		return getSnapshotVersion(path)
+	}, backend == PebbleBackend)
+}
+
+func newReadOnlyKVStore(open func() (ds.Datastore, error), version func() (snapshotVersion, error), exclusive bool) (*ReadOnlyKVStore, error) {
+	s := &ReadOnlyKVStore{open: open, version: version, exclusive: exclusive}
+	v, err := version()
+	if err != nil {
+		return nil, err
+	}
+	kv, err := open()
+	if err != nil {
+		return nil, err
+	}
+	s.current = &readOnlyHandle{kv: kv, version: v}
+	return s, nil
+}
+
+func openReadOnly(backend, path string) (ds.Datastore, error) {
+	// some backends create missing directories even in read-only mode
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	switch backend {
+	case "", BadgerBackend:
+		opts := badger4.DefaultOptions
+		opts.Options = opts.Options.WithReadOnly(true)
+		// garbage collection writes to the store
+		opts.GcInterval = 0
+		return badger4.NewDatastore(path, &opts)
+	case PebbleBackend:
+		opts := &pebble.Options{ReadOnly: true}
+		opts.EnsureDefaults()
+		return pebbleds.NewDatastore(path, opts)
+	case LevelDBBackend:
+		return leveldb.NewDatastore(path, &leveldb.Options{ReadOnly: true, ErrorIfMissing: true})
+	default:
+		return nil, fmt.Errorf("unknown db backend: %q (supported: %s, %s, %s)", backend, BadgerBackend, PebbleBackend, LevelDBBackend)
+	}
+}
+
+// getSnapshotVersion returns the version of the contents of the store directory at given path.
+//
+// Lock files are skipped, as Pebble touches its lock file whenever the store is opened, also in read-only mode.
+func getSnapshotVersion(path string) (snapshotVersion, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return snapshotVersion{}, err
+	}
+	v := snapshotVersion{modTime: info.ModTime().UnixNano()}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return snapshotVersion{}, err
+	}
+	for _, entry := range entries {
+		if entry.Name() == "LOCK" {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return snapshotVersion{}, err
+		}
+		v.files++
+		v.size += info.Size()
+		v.modTime = max(v.modTime, info.ModTime().UnixNano())
+	}
+	return v, nil
+}
+
+// Refresh reopens the underlying store if the snapshot was replaced since it was opened, making new data visible. It
+// returns true if the store was reopened.
+//
+// The new snapshot is opened before the old one is closed, so reads are not blocked: reads started before the refresh
+// (including open query results) use the old snapshot, which is closed after they're finished. Pebble can't open the
+// same store twice in a single process, so it's closed before reopening, and reads fail until it's reopened. If the
+// new snapshot can't be opened, the old one is still used.
+func (s *ReadOnlyKVStore) Refresh() (bool, error) {
+	s.refreshMtx.Lock()
+	defer s.refreshMtx.Unlock()
+
+	v, err := s.version()
+	if err != nil {
+		return false, err
+	}
+	s.mtx.RLock()
+	current := s.current
+	s.mtx.RUnlock()
+	if current != nil && current.version == v {
+		return false, nil
+	}
+
+	if s.exclusive && current != nil {
+		if err := s.swap(nil).close(); err != nil {
+			return false, err
+		}
+	}
+	kv, err := s.open()
+	if err != nil {
+		return false, err
+	}
+	old := s.swap(&readOnlyHandle{kv: kv, version: v})
+	if old != nil {
+		s.closing.Add(1)
+		go func() {
+			defer s.closing.Done()
+			_ = old.close()
+		}()
+	}
+	return true, nil
+}
+
+// swap replaces the underlying store and returns the previous one.
+func (s *ReadOnlyKVStore) swap(handle *readOnlyHandle) *readOnlyHandle {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	old := s.current
+	s.current = handle
+	return old
+}
+
+// close closes the underlying store after all the reads using it are finished.
+func (h *readOnlyHandle) close() error {
+	if h == nil {
+		return nil
+	}
+	h.reads.Wait()
+	return h.kv.Close()
+}
+
+// acquire returns the current underlying store, which is not closed until release is called.
+func (s *ReadOnlyKVStore) acquire() (*readOnlyHandle, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	if s.current == nil {
+		return nil, errNotOpen
+	}
+	s.current.reads.Add(1)
+	return s.current, nil
+}
+
+// Get implements ds.Read.
+func (s *ReadOnlyKVStore) Get(ctx context.Context, key ds.Key) ([]byte, error) {
+	h, err := s.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer h.reads.Done()
+	return h.kv.Get(ctx, key)
+}
+
+// Has implements ds.Read.
+func (s *ReadOnlyKVStore) Has(ctx context.Context, key ds.Key) (bool, error) {
+	h, err := s.acquire()
+	if err != nil {
+		return false, err
+	}
+	defer h.reads.Done()
+	return h.kv.Has(ctx, key)
+}
+
+// GetSize implements ds.Read.
+func (s *ReadOnlyKVStore) GetSize(ctx context.Context, key ds.Key) (int, error) {
+	h, err := s.acquire()
+	if err != nil {
+		return -1, err
+	}
+	defer h.reads.Done()
+	return h.kv.GetSize(ctx, key)
+}
+
+// Query implements ds.Read.
+//
+// Results are streamed from the snapshot opened when the query was made. They must be read until the end or closed,
+// as the snapshot isn't closed by Refresh and Close until then.
+func (s *ReadOnlyKVStore) Query(ctx context.Context, q dsq.Query) (dsq.Results, error) {
+	h, err := s.acquire()
+	if err != nil {
+		return nil, err
+	}
+	results, err := h.kv.Query(ctx, q)
+	if err != nil {
+		h.reads.Done()
+		return nil, err
+	}
+	var once sync.Once
+	return dsq.ResultsFromIterator(q, dsq.Iterator{
+		Next: results.NextSync,
+		Close: func() error {
+			err := results.Close()
+			once.Do(h.reads.Done)
+			return err
+		},
+	}), nil
+}
+
+// Put always returns ErrReadOnly.
+func (s *ReadOnlyKVStore) Put(ctx context.Context, key ds.Key, value []byte) error {
+	return ErrReadOnly
+}
+
+// Delete always returns ErrReadOnly.
+func (s *ReadOnlyKVStore) Delete(ctx context.Context, key ds.Key) error {
+	return ErrReadOnly
+}
+
+// Sync is a no-op, as there are no writes to flush.
+func (s *ReadOnlyKVStore) Sync(ctx context.Context, prefix ds.Key) error {
+	return nil
+}
+
+// Close closes the underlying store, after all the reads using it are finished.
+func (s *ReadOnlyKVStore) Close() error {
+	s.refreshMtx.Lock()
+	defer s.refreshMtx.Unlock()
+	err := s.swap(nil).close()
+	s.closing.Wait()
+	return err
+}
+
+// NewTransaction implements ds.TxnDatastore. Writes within the transaction fail with ErrReadOnly.
+func (s *ReadOnlyKVStore) NewTransaction(ctx context.Context, readOnly bool) (ds.Txn, error) {
+	return &readOnlyTxn{store: s}, nil
+}
+
+// Batch implements ds.Batching. Writes within the batch fail with ErrReadOnly.
+func (s *ReadOnlyKVStore) Batch(ctx context.Context) (ds.Batch, error) {
+	return &readOnlyTxn{store: s}, nil
+}
+
+type readOnlyTxn struct {
+	store *ReadOnlyKVStore
+}
+
+func (t *readOnlyTxn) Get(ctx context.Context, key ds.Key) ([]byte, error) {
+	return t.store.Get(ctx, key)
+}
+
+func (t *readOnlyTxn) Has(ctx context.Context, key ds.Key) (bool, error) {
+	return t.store.Has(ctx, key)
+}
+
+func (t *readOnlyTxn) GetSize(ctx context.Context, key ds.Key) (int, error) {
+	return t.store.GetSize(ctx, key)
+}
+
+func (t *readOnlyTxn) Query(ctx context.Context, q dsq.Query) (dsq.Results, error) {
+	return t.store.Query(ctx, q)
+}
+
+func (t *readOnlyTxn) Put(ctx context.Context, key ds.Key, value []byte) error {
+	return ErrReadOnly
+}
+
+func (t *readOnlyTxn) Delete(ctx context.Context, key ds.Key) error {
+	return ErrReadOnly
+}
+
+// Commit is a no-op, as there are no writes to commit.
+func (t *readOnlyTxn) Commit(ctx context.Context) error {
+	return nil
+}
+
+func (t *readOnlyTxn) Discard(ctx context.Context) {}
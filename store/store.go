@@ -1,15 +1,22 @@
 package store
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"sync/atomic"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtypes "github.com/cometbft/cometbft/types"
 	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
 
 	"github.com/celestiaorg/go-header"
 
@@ -25,6 +32,10 @@ var (
 	statePrefix          = "s"
 	responsesPrefix      = "r"
 	metaPrefix           = "m"
+	proofPrefix          = "p"
+	paramsPrefix         = "cp"
+	timePrefix           = "t"
+	validatorsPrefix     = "v"
 )
 
 // DefaultStore is a default store implmementation.
@@ -97,6 +108,15 @@ func (s *DefaultStore) SaveBlock(ctx context.Context, block *types.Block, commit
 	if err != nil {
 		return fmt.Errorf("failed to create a new key using height of the block: %w", err)
 	}
+	timeBlob := make([]byte, 8)
+	binary.BigEndian.PutUint64(timeBlob, uint64(block.Time().UnixNano()))
+	err = bb.Put(ctx, ds.NewKey(getTimeKey(block.Height())), timeBlob)
+	if err != nil {
+		return fmt.Errorf("failed to create a new key for block time: %w", err)
+	}
+	if err := s.saveValidators(ctx, bb, block.Height(), block.SignedHeader.Validators); err != nil {
+		return err
+	}
 
 	if err = bb.Commit(ctx); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
@@ -119,7 +139,11 @@ func (s *DefaultStore) GetBlock(ctx context.Context, height uint64) (*types.Bloc
 
 // GetBlockByHash returns block with given block header hash, or error if it's not found in Store.
 func (s *DefaultStore) GetBlockByHash(ctx context.Context, hash types.Hash) (*types.Block, error) {
-	blockData, err := s.db.Get(ctx, ds.NewKey(getBlockKey(hash)))
+	return getBlock(ctx, s.db, hash)
+}
+
+func getBlock(ctx context.Context, r ds.Read, hash types.Hash) (*types.Block, error) {
+	blockData, err := r.Get(ctx, ds.NewKey(getBlockKey(hash)))
 	if err != nil {
 		return nil, fmt.Errorf("failed to load block data: %w", err)
 	}
@@ -132,6 +156,212 @@ func (s *DefaultStore) GetBlockByHash(ctx context.Context, hash types.Hash) (*ty
 	return block, nil
 }
 
+// getHeader decodes only the signed header of the block with given hash.
+func getHeader(ctx context.Context, r ds.Read, hash types.Hash) (*types.SignedHeader, error) {
+	blockData, err := r.Get(ctx, ds.NewKey(getBlockKey(hash)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load block data: %w", err)
+	}
+	var pbHeader pb.BlockSignedHeader
+	if err := pbHeader.Unmarshal(blockData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal block header: %w", err)
+	}
+	header := new(types.SignedHeader)
+	if err := header.FromProto(pbHeader.SignedHeader); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal block header: %w", err)
+	}
+	return header, nil
+}
+
+// GetBlockByTime returns the last block with time not after the given time.
+//
+// Block times are strictly increasing with height, so the block is found by binary search over block times saved by
+// SaveBlock, between the initial height and the height of the store.
+func (s *DefaultStore) GetBlockByTime(ctx context.Context, t time.Time) (*types.Block, error) {
+	low := uint64(1)
+	if state, err := s.GetState(ctx); err == nil && state.InitialHeight > 0 {
+		low = state.InitialHeight
+	}
+	high := s.Height()
+	notFound := fmt.Errorf("failed to find block at or before %v: %w", t, ds.ErrNotFound)
+	if high < low {
+		return nil, notFound
+	}
+	first, err := s.getBlockTime(ctx, low)
+	if err != nil {
+		return nil, err
+	}
+	if first.After(t) {
+		return nil, notFound
+	}
+
+	// invariant: block at low is not after t, blocks above high are after t
+	for low < high {
+		mid := low + (high-low+1)/2
+		blockTime, err := s.getBlockTime(ctx, mid)
+		if err != nil {
+			return nil, err
+		}
+		if blockTime.After(t) {
+			high = mid - 1
+		} else {
+			low = mid
+		}
+	}
+	return s.GetBlock(ctx, low)
+}
+
+// getBlockTime returns time of the block at given height, from the time index if possible.
+func (s *DefaultStore) getBlockTime(ctx context.Context, height uint64) (time.Time, error) {
+	blob, err := s.db.Get(ctx, ds.NewKey(getTimeKey(height)))
+	if err == nil && len(blob) == 8 {
+		return time.Unix(0, int64(binary.BigEndian.Uint64(blob))), nil
+	}
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return time.Time{}, fmt.Errorf("failed to load block time for height %v: %w", height, err)
+	}
+	// blocks saved before the time index was introduced
+	header, err := s.GetHeader(ctx, height)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return header.Time(), nil
+}
+
+// GetBlocks returns blocks in the given inclusive height range, ordered by height.
+func (s *DefaultStore) GetBlocks(ctx context.Context, from, to uint64) ([]*types.Block, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid height range: %d-%d", from, to)
+	}
+	blocks := make([]*types.Block, 0, min(to-from+1, maxPreallocatedBlocks))
+	err := s.IterateBlocks(ctx, from, to, func(block *types.Block) error {
+		blocks = append(blocks, block)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// IterateBlocks calls fn for each block in the given inclusive height range, in order of height.
+//
+// All blocks are read from a single read-only transaction, so they're consistent with each other. Iteration stops on
+// the first error, either from reading a block or returned by fn.
+func (s *DefaultStore) IterateBlocks(ctx context.Context, from, to uint64, fn func(*types.Block) error) error {
+	return s.iterate(ctx, from, to, func(txn ds.Read, hash types.Hash) error {
+		block, err := getBlock(ctx, txn, hash)
+		if err != nil {
+			return err
+		}
+		return fn(block)
+	})
+}
+
+// GetHeader returns signed header of the block at given height, without decoding the block data.
+func (s *DefaultStore) GetHeader(ctx context.Context, height uint64) (*types.SignedHeader, error) {
+	h, err := s.loadHashFromIndex(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load hash from index: %w", err)
+	}
+	return getHeader(ctx, s.db, h)
+}
+
+// GetHeaders returns signed headers of blocks in the given inclusive height range, ordered by height, without decoding
+// the block data.
+func (s *DefaultStore) GetHeaders(ctx context.Context, from, to uint64) ([]*types.SignedHeader, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid height range: %d-%d", from, to)
+	}
+	headers := make([]*types.SignedHeader, 0, min(to-from+1, maxPreallocatedBlocks))
+	err := s.iterate(ctx, from, to, func(txn ds.Read, hash types.Hash) error {
+		header, err := getHeader(ctx, txn, hash)
+		if err != nil {
+			return err
+		}
+		headers = append(headers, header)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return headers, nil
+}
+
+// iterate calls fn with hashes of blocks in the given inclusive height range, within a single read-only transaction.
+//
+// The height to hash index is read with ordered prefix queries of the index buckets covering the range.
+func (s *DefaultStore) iterate(ctx context.Context, from, to uint64, fn func(txn ds.Read, hash types.Hash) error) error {
+	txn, err := s.db.NewTransaction(ctx, true)
+	if err != nil {
+		return fmt.Errorf("failed to create a new transaction: %w", err)
+	}
+	defer txn.Discard(ctx)
+
+	next := from
+	for bucket := from / indexBucketSize; bucket <= to/indexBucketSize; bucket++ {
+		var done bool
+		next, done, err = iterateIndexBucket(ctx, txn, bucket, next, to, fn)
+		if err != nil || done {
+			return err
+		}
+		if next/indexBucketSize == bucket {
+			// rest of the bucket is missing
+			break
+		}
+	}
+	return errMissingIndexEntry(next)
+}
+
+// errMissingIndexEntry returns the error for missing height to hash index entry, as returned by loadHashFromIndex.
+func errMissingIndexEntry(height uint64) error {
+	return fmt.Errorf("failed to load hash from index: failed to load block hash for height %v: %w", height, ds.ErrNotFound)
+}
+
+// iterateIndexBucket calls fn with hashes of blocks from the given index bucket, starting at height next and ending at
+// height to. The next height to read and true if the height to was reached are returned.
+func iterateIndexBucket(ctx context.Context, txn ds.Read, bucket, next, to uint64, fn func(txn ds.Read, hash types.Hash) error) (uint64, bool, error) {
+	results, err := txn.Query(ctx, dsq.Query{
+		Prefix: getIndexBucketKey(bucket),
+		Orders: []dsq.Order{dsq.OrderByKey{}},
+	})
+	if err != nil {
+		return next, false, fmt.Errorf("failed to query index: %w", err)
+	}
+	defer results.Close()
+
+	for result := range results.Next() {
+		if err := ctx.Err(); err != nil {
+			return next, false, err
+		}
+		if result.Error != nil {
+			return next, false, fmt.Errorf("failed to query index: %w", result.Error)
+		}
+		height, err := strconv.ParseUint(ds.RawKey(result.Key).Name(), 10, 64)
+		if err != nil {
+			return next, false, fmt.Errorf("invalid index key %s: %w", result.Key, err)
+		}
+		if height < next {
+			continue
+		}
+		if height > next {
+			return next, false, errMissingIndexEntry(next)
+		}
+		if len(result.Value) != 32 {
+			return next, false, errors.New("invalid hash length")
+		}
+		if err := fn(txn, result.Value); err != nil {
+			return next, false, err
+		}
+		if height == to {
+			// avoids overflow if to is the max uint64
+			return next, true, nil
+		}
+		next++
+	}
+	return next, false, nil
+}
+
 // SaveBlockResponses saves block responses (events, tx responses, validator set updates, etc) in Store.
 func (s *DefaultStore) SaveBlockResponses(ctx context.Context, height uint64, responses *abci.ResponseFinalizeBlock) error {
 	data, err := responses.Marshal()
@@ -201,8 +431,33 @@ func (s *DefaultStore) GetExtendedCommit(ctx context.Context, height uint64) (*a
 	return extendedCommit, nil
 }
 
+// SaveValidityProof saves validity proof of a block in Store.
+func (s *DefaultStore) SaveValidityProof(ctx context.Context, proof *types.ValidityProof) error {
+	blob, err := proof.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to marshal validity proof: %w", err)
+	}
+	return s.db.Put(ctx, ds.NewKey(getProofKey(proof.Height)), blob)
+}
+
+// GetValidityProof returns validity proof of a block at given height, or error if it's not found in Store.
+func (s *DefaultStore) GetValidityProof(ctx context.Context, height uint64) (*types.ValidityProof, error) {
+	blob, err := s.db.Get(ctx, ds.NewKey(getProofKey(height)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load validity proof for height %v: %w", height, err)
+	}
+	proof := new(types.ValidityProof)
+	err = proof.UnmarshalBinary(blob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal validity proof: %w", err)
+	}
+	return proof, nil
+}
+
 // UpdateState updates state saved in Store. Only one State is stored.
 // If there is no State in Store, state will be saved.
+//
+// Consensus params are additionally saved when they change, to be available for past heights.
 func (s *DefaultStore) UpdateState(ctx context.Context, state types.State) error {
 	pbState, err := state.ToProto()
 	if err != nil {
@@ -212,9 +467,121 @@ func (s *DefaultStore) UpdateState(ctx context.Context, state types.State) error
 	if err != nil {
 		return err
 	}
+	if err := s.saveConsensusParams(ctx, state.LastHeightConsensusParamsChanged, state.ConsensusParams); err != nil {
+		return err
+	}
 	return s.db.Put(ctx, ds.NewKey(getStateKey()), data)
 }
 
+// saveConsensusParams saves consensus params in effect since given height, unless they're already saved.
+func (s *DefaultStore) saveConsensusParams(ctx context.Context, height uint64, params cmproto.ConsensusParams) error {
+	key := ds.NewKey(getParamsKey(height))
+	has, err := s.db.Has(ctx, key)
+	if err != nil || has {
+		return err
+	}
+	data, err := params.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal consensus params: %w", err)
+	}
+	return s.db.Put(ctx, key, data)
+}
+
+// GetConsensusParams returns consensus params in effect at given height.
+//
+// Params are saved by UpdateState when they change, so the params saved at the highest height not above the given
+// height are returned.
+func (s *DefaultStore) GetConsensusParams(ctx context.Context, height uint64) (*cmproto.ConsensusParams, error) {
+	changed, err := s.lastChangeHeight(ctx, paramsPrefix, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load consensus params for height %v: %w", height, err)
+	}
+	data, err := s.db.Get(ctx, ds.NewKey(getParamsKey(changed)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load consensus params for height %v: %w", height, err)
+	}
+	params := new(cmproto.ConsensusParams)
+	if err := params.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal consensus params: %w", err)
+	}
+	return params, nil
+}
+
+// saveValidators saves validator set of a block at given height, if it's different from the validator set in effect
+// at that height.
+func (s *DefaultStore) saveValidators(ctx context.Context, w ds.Write, height uint64, validators *cmtypes.ValidatorSet) error {
+	if validators == nil {
+		return nil
+	}
+	current, err := s.GetValidators(ctx, height)
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return err
+	}
+	if current != nil && bytes.Equal(current.Hash(), validators.Hash()) {
+		return nil
+	}
+	pbValidators, err := validators.ToProto()
+	if err != nil {
+		return fmt.Errorf("failed to marshal validator set: %w", err)
+	}
+	data, err := pbValidators.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal validator set: %w", err)
+	}
+	return w.Put(ctx, ds.NewKey(getValidatorsKey(height)), data)
+}
+
+// GetValidators returns validator set in effect at given height.
+//
+// Validator sets are saved by SaveBlock when they change, so the validator set saved at the highest height not above
+// the given height is returned.
+func (s *DefaultStore) GetValidators(ctx context.Context, height uint64) (*cmtypes.ValidatorSet, error) {
+	changed, err := s.lastChangeHeight(ctx, validatorsPrefix, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load validator set for height %v: %w", height, err)
+	}
+	data, err := s.db.Get(ctx, ds.NewKey(getValidatorsKey(changed)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load validator set for height %v: %w", height, err)
+	}
+	pbValidators := new(cmproto.ValidatorSet)
+	if err := pbValidators.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal validator set: %w", err)
+	}
+	return cmtypes.ValidatorSetFromProto(pbValidators)
+}
+
+// lastChangeHeight returns the highest height not above given height, under which a value is saved with given prefix.
+//
+// It's used for data saved only when it changes, like consensus params and validator sets. Keys are ordered from the
+// highest height (see getChangeKey), so only the keys of changes above the given height are skipped, and a single key
+// is read for the latest height.
+func (s *DefaultStore) lastChangeHeight(ctx context.Context, prefix string, height uint64) (uint64, error) {
+	results, err := s.db.Query(ctx, dsq.Query{
+		Prefix:   GenerateKey([]string{prefix}),
+		KeysOnly: true,
+		Orders:   []dsq.Order{dsq.OrderByKey{}},
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer results.Close()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			return 0, result.Error
+		}
+		h, err := parseChangeKey(ds.RawKey(result.Key))
+		if err != nil {
+			return 0, err
+		}
+		if h <= height {
+			return h, nil
+		}
+	}
+	return 0, ds.ErrNotFound
+}
+
 // GetState returns last state saved with UpdateState.
 func (s *DefaultStore) GetState(ctx context.Context) (types.State, error) {
 	blob, err := s.db.Get(ctx, ds.NewKey(getStateKey()))
@@ -254,7 +621,11 @@ func (s *DefaultStore) GetMetadata(ctx context.Context, key string) ([]byte, err
 
 // loadHashFromIndex returns the hash of a block given its height
 func (s *DefaultStore) loadHashFromIndex(ctx context.Context, height uint64) (header.Hash, error) {
-	blob, err := s.db.Get(ctx, ds.NewKey(getIndexKey(height)))
+	return loadHashFromIndex(ctx, s.db, height)
+}
+
+func loadHashFromIndex(ctx context.Context, r ds.Read, height uint64) (header.Hash, error) {
+	blob, err := r.Get(ctx, ds.NewKey(getIndexKey(height)))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to load block hash for height %v: %w", height, err)
@@ -277,7 +648,27 @@ func getExtendedCommitKey(height uint64) string {
 	return GenerateKey([]string{extendedCommitPrefix, strconv.FormatUint(height, 10)})
 }
 
+// maxPreallocatedBlocks limits the capacity preallocated for blocks read from a height range, as the range can be
+// larger than the number of saved blocks.
+const maxPreallocatedBlocks = 1000
+
+// indexBucketSize is the number of heights in a single bucket of the height to hash index.
+const indexBucketSize = 1000
+
+// getIndexKey returns the key of the height to hash index entry for given height.
+//
+// Heights are zero-padded, so keys are ordered by height, and grouped into buckets of indexBucketSize heights, so a
+// height range can be read with prefix queries of the buckets covering it.
 func getIndexKey(height uint64) string {
+	return GenerateKey([]string{getIndexBucketKey(height / indexBucketSize), fmt.Sprintf("%020d", height)})
+}
+
+func getIndexBucketKey(bucket uint64) string {
+	return GenerateKey([]string{indexPrefix, fmt.Sprintf("%020d", bucket)})
+}
+
+// getLegacyIndexKey returns the key of the height to hash index entry for given height used before schema version 3.
+func getLegacyIndexKey(height uint64) string {
 	return GenerateKey([]string{indexPrefix, strconv.FormatUint(height, 10)})
 }
 
@@ -289,6 +680,46 @@ func getResponsesKey(height uint64) string {
 	return GenerateKey([]string{responsesPrefix, strconv.FormatUint(height, 10)})
 }
 
+func getProofKey(height uint64) string {
+	return GenerateKey([]string{proofPrefix, strconv.FormatUint(height, 10)})
+}
+
+func getParamsKey(height uint64) string {
+	return getChangeKey(paramsPrefix, height)
+}
+
+func getValidatorsKey(height uint64) string {
+	return getChangeKey(validatorsPrefix, height)
+}
+
+// getChangeKey returns the key of data saved with given prefix when it changes at given height.
+//
+// Heights are subtracted from the maximal height and zero-padded, so keys are ordered from the highest height, and the
+// last change up to a height is found by iterating keys in order, as the key-value stores don't support reverse seeks.
+func getChangeKey(prefix string, height uint64) string {
+	return GenerateKey([]string{prefix, fmt.Sprintf("%020d", math.MaxUint64-height)})
+}
+
+// parseChangeKey returns the height of a key returned by getChangeKey.
+func parseChangeKey(key ds.Key) (uint64, error) {
+	name := key.Name()
+	inverted, err := strconv.ParseUint(name, 10, 64)
+	if err != nil || len(name) != 20 {
+		return 0, fmt.Errorf("invalid key %s", key)
+	}
+	return math.MaxUint64 - inverted, nil
+}
+
+// getLegacyChangeKey returns the key of data saved with given prefix when it changes at given height used before
+// schema version 4.
+func getLegacyChangeKey(prefix string, height uint64) string {
+	return GenerateKey([]string{prefix, strconv.FormatUint(height, 10)})
+}
+
+func getTimeKey(height uint64) string {
+	return GenerateKey([]string{timePrefix, strconv.FormatUint(height, 10)})
+}
+
 func getMetaKey(key string) string {
 	return GenerateKey([]string{metaPrefix, key})
 }
@@ -2,9 +2,13 @@ package store
 
 import (
 	"context"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"math"
 	"os"
 	"testing"
+	"time"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -281,3 +285,276 @@ func TestExtendedCommits(t *testing.T) {
 	require.NoError(err)
 	require.Equal(expected, commit)
 }
+
+func TestValidityProofs(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv)
+
+	// reading before saving returns error
+	proof, err := s.GetValidityProof(ctx, 1)
+	require.Error(err)
+	require.ErrorIs(err, ds.ErrNotFound)
+	require.Nil(proof)
+
+	expected := &types.ValidityProof{
+		Height:    10,
+		BlockHash: types.GetRandomBytes(32),
+		Proof:     []byte("just trust me bro"),
+	}
+
+	err = s.SaveValidityProof(ctx, expected)
+	require.NoError(err)
+	proof, err = s.GetValidityProof(ctx, 10)
+	require.NoError(err)
+	require.Equal(expected, proof)
+}
+
+func TestConsensusParams(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv)
+
+	_, err = s.GetConsensusParams(ctx, 1)
+	require.ErrorIs(err, ds.ErrNotFound)
+
+	state := types.State{
+		LastHeightConsensusParamsChanged: 1,
+		ConsensusParams:                  cmproto.ConsensusParams{Block: &cmproto.BlockParams{MaxBytes: 100}},
+	}
+	require.NoError(s.UpdateState(ctx, state))
+
+	// params changed at height 5 are saved, but params changed at height 1 are not overwritten
+	state.ConsensusParams = cmproto.ConsensusParams{Block: &cmproto.BlockParams{MaxBytes: 200}}
+	require.NoError(s.UpdateState(ctx, state))
+	state.LastHeightConsensusParamsChanged = 5
+	require.NoError(s.UpdateState(ctx, state))
+	state.LastHeightConsensusParamsChanged = 12
+	state.ConsensusParams = cmproto.ConsensusParams{Block: &cmproto.BlockParams{MaxBytes: 300}}
+	require.NoError(s.UpdateState(ctx, state))
+
+	cases := []struct {
+		height   uint64
+		maxBytes int64
+	}{
+		{1, 100}, {4, 100}, {5, 200}, {11, 200}, {12, 300}, {100, 300},
+	}
+	for _, c := range cases {
+		params, err := s.GetConsensusParams(ctx, c.height)
+		require.NoError(err)
+		require.Equal(c.maxBytes, params.Block.MaxBytes, "height %d", c.height)
+	}
+	_, err = s.GetConsensusParams(ctx, 0)
+	require.ErrorIs(err, ds.ErrNotFound)
+}
+
+func TestValidators(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv)
+
+	_, err = s.GetValidators(ctx, 1)
+	require.ErrorIs(err, ds.ErrNotFound)
+
+	// validator set changes at height 4
+	blocks, _, state := saveTestChain(t, s, 3)
+	block := types.GetRandomBlock(4, 1)
+	require.NoError(s.SaveBlock(ctx, block, &block.SignedHeader.Commit))
+	state.LastBlockHeight = 4
+	require.NoError(s.UpdateState(ctx, state))
+
+	cases := []struct {
+		height uint64
+		block  *types.Block
+	}{
+		{1, blocks[0]}, {3, blocks[0]}, {4, block}, {100, block},
+	}
+	for _, c := range cases {
+		validators, err := s.GetValidators(ctx, c.height)
+		require.NoError(err)
+		assert.Equal(c.block.SignedHeader.Validators.Hash(), validators.Hash(), "height %d", c.height)
+	}
+	_, err = s.GetValidators(ctx, 0)
+	require.ErrorIs(err, ds.ErrNotFound)
+
+	// only changes are saved
+	results, err := PrefixEntries(ctx, kv, GenerateKey([]string{validatorsPrefix}))
+	require.NoError(err)
+	entries, err := results.Rest()
+	require.NoError(err)
+	assert.Len(entries, 2)
+
+	// validator sets are restored by migration
+	for _, entry := range entries {
+		require.NoError(kv.Delete(ctx, ds.NewKey(entry.Key)))
+	}
+	_, err = s.GetValidators(ctx, 4)
+	require.ErrorIs(err, ds.ErrNotFound)
+	useLegacyIndex(t, kv, 4)
+	require.NoError(migrateValidators(ctx, s))
+	validators, err := s.GetValidators(ctx, 4)
+	require.NoError(err)
+	assert.Equal(block.SignedHeader.Validators.Hash(), validators.Hash())
+	validators, err = s.GetValidators(ctx, 2)
+	require.NoError(err)
+	assert.Equal(blocks[0].SignedHeader.Validators.Hash(), validators.Hash())
+}
+
+func TestGetBlocks(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv)
+	blocks, _, _ := saveTestChain(t, s, 5)
+
+	got, err := s.GetBlocks(ctx, 2, 4)
+	require.NoError(err)
+	require.Len(got, 3)
+	for i, b := range got {
+		assert.Equal(blocks[i+1], b)
+	}
+
+	headers, err := s.GetHeaders(ctx, 1, 5)
+	require.NoError(err)
+	require.Len(headers, 5)
+	for i, h := range headers {
+		assert.Equal(blocks[i].SignedHeader.Hash(), h.Hash())
+		assert.Equal(blocks[i].SignedHeader.Commit, h.Commit)
+	}
+	header, err := s.GetHeader(ctx, 3)
+	require.NoError(err)
+	assert.Equal(&blocks[2].SignedHeader, header)
+
+	// iteration stops on error returned by callback
+	var heights []uint64
+	stop := errors.New("stop")
+	err = s.IterateBlocks(ctx, 1, 5, func(b *types.Block) error {
+		heights = append(heights, b.Height())
+		if b.Height() == 3 {
+			return stop
+		}
+		return nil
+	})
+	assert.ErrorIs(err, stop)
+	assert.Equal([]uint64{1, 2, 3}, heights)
+
+	_, err = s.GetBlocks(ctx, 4, 6)
+	assert.ErrorIs(err, ds.ErrNotFound)
+	_, err = s.GetHeaders(ctx, 3, 2)
+	assert.Error(err)
+	_, err = s.GetHeader(ctx, 6)
+	assert.ErrorIs(err, ds.ErrNotFound)
+	// huge ranges fail on the first missing height
+	_, err = s.GetBlocks(ctx, 1, math.MaxUint64)
+	assert.ErrorIs(err, ds.ErrNotFound)
+}
+
+func TestIterateIndexBuckets(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv).(*DefaultStore)
+
+	heights := []uint64{indexBucketSize - 2, indexBucketSize - 1, indexBucketSize, indexBucketSize + 1, 3 * indexBucketSize, math.MaxUint64 - 1, math.MaxUint64}
+	for _, h := range heights {
+		hash := make([]byte, 32)
+		binary.BigEndian.PutUint64(hash, h)
+		require.NoError(kv.Put(ctx, ds.NewKey(getIndexKey(h)), hash))
+	}
+	collect := func(from, to uint64) ([]uint64, error) {
+		var got []uint64
+		err := s.iterate(ctx, from, to, func(_ ds.Read, hash types.Hash) error {
+			got = append(got, binary.BigEndian.Uint64(hash))
+			return nil
+		})
+		return got, err
+	}
+
+	got, err := collect(indexBucketSize-2, indexBucketSize+1)
+	require.NoError(err)
+	assert.Equal(heights[:4], got)
+	got, err = collect(math.MaxUint64-1, math.MaxUint64)
+	require.NoError(err)
+	assert.Equal(heights[5:], got)
+	got, err = collect(3*indexBucketSize, 3*indexBucketSize)
+	require.NoError(err)
+	assert.Equal(heights[4:5], got)
+
+	got, err = collect(indexBucketSize-1, indexBucketSize+2)
+	assert.ErrorIs(err, ds.ErrNotFound)
+	assert.Equal(heights[1:4], got)
+	_, err = collect(indexBucketSize-3, indexBucketSize)
+	assert.ErrorIs(err, ds.ErrNotFound)
+	_, err = collect(2*indexBucketSize, 3*indexBucketSize)
+	assert.ErrorIs(err, ds.ErrNotFound)
+}
+
+func TestGetBlockByTime(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv)
+
+	_, err = s.GetBlockByTime(ctx, time.Now())
+	assert.ErrorIs(err, ds.ErrNotFound)
+
+	blocks, _, _ := saveTestChain(t, s, 7)
+	s.SetHeight(ctx, 7)
+
+	for _, b := range blocks {
+		block, err := s.GetBlockByTime(ctx, b.Time())
+		require.NoError(err)
+		assert.Equal(b.Height(), block.Height())
+
+		block, err = s.GetBlockByTime(ctx, b.Time().Add(time.Nanosecond))
+		require.NoError(err)
+		assert.Equal(b.Height(), block.Height())
+	}
+	block, err := s.GetBlockByTime(ctx, blocks[6].Time().Add(time.Hour))
+	require.NoError(err)
+	assert.Equal(uint64(7), block.Height())
+
+	_, err = s.GetBlockByTime(ctx, blocks[0].Time().Add(-time.Nanosecond))
+	assert.ErrorIs(err, ds.ErrNotFound)
+
+	// blocks saved without time index are found using block headers
+	require.NoError(kv.Delete(ctx, ds.NewKey(getTimeKey(4))))
+	block, err = s.GetBlockByTime(ctx, blocks[3].Time())
+	require.NoError(err)
+	assert.Equal(uint64(4), block.Height())
+}
@@ -0,0 +1,58 @@
+package store
+
+import (
+	"context"
+
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+)
+
+// batchTxnDatastore implements ds.TxnDatastore on top of a datastore supporting only batching (e.g. pebble).
+//
+// Writes of a transaction are atomically applied on commit, but reads within a transaction are served directly from
+// the datastore, so they don't see uncommitted writes. Rollkit uses transactions only for atomic writes.
+type batchTxnDatastore struct {
+	ds.Batching
+}
+
+var (
+	_ ds.TxnDatastore        = &batchTxnDatastore{}
+	_ ds.PersistentDatastore = &batchTxnDatastore{}
+)
+
+// DiskUsage implements ds.PersistentDatastore, returning the disk usage of the wrapped datastore.
+func (d *batchTxnDatastore) DiskUsage(ctx context.Context) (uint64, error) {
+	return ds.DiskUsage(ctx, d.Batching)
+}
+
+func (d *batchTxnDatastore) NewTransaction(ctx context.Context, readOnly bool) (ds.Txn, error) {
+	batch, err := d.Batch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &batchTxn{Batch: batch, store: d.Batching}, nil
+}
+
+type batchTxn struct {
+	ds.Batch
+	store ds.Datastore
+}
+
+func (t *batchTxn) Get(ctx context.Context, key ds.Key) ([]byte, error) {
+	return t.store.Get(ctx, key)
+}
+
+func (t *batchTxn) Has(ctx context.Context, key ds.Key) (bool, error) {
+	return t.store.Has(ctx, key)
+}
+
+func (t *batchTxn) GetSize(ctx context.Context, key ds.Key) (int, error) {
+	return t.store.GetSize(ctx, key)
+}
+
+func (t *batchTxn) Query(ctx context.Context, q dsq.Query) (dsq.Results, error) {
+	return t.store.Query(ctx, q)
+}
+
+// Discard drops the writes of the transaction; it's a no-op after Commit.
+func (t *batchTxn) Discard(ctx context.Context) {}
@@ -2,8 +2,11 @@ package store
 
 import (
 	"context"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/rollkit/rollkit/types"
 )
@@ -23,6 +26,20 @@ type Store interface {
 	GetBlock(ctx context.Context, height uint64) (*types.Block, error)
 	// GetBlockByHash returns block with given block header hash, or error if it's not found in Store.
 	GetBlockByHash(ctx context.Context, hash types.Hash) (*types.Block, error)
+	// GetBlockByTime returns the last block with time not after the given time, or error if there is no such block.
+	GetBlockByTime(ctx context.Context, t time.Time) (*types.Block, error)
+	// GetBlocks returns blocks in the given inclusive height range, ordered by height, or error if any of them is not
+	// found in Store.
+	GetBlocks(ctx context.Context, from, to uint64) ([]*types.Block, error)
+	// IterateBlocks calls fn for each block in the given inclusive height range, in order of height, until an error is
+	// encountered.
+	IterateBlocks(ctx context.Context, from, to uint64, fn func(*types.Block) error) error
+
+	// GetHeader returns signed header of the block at given height, without decoding block data.
+	GetHeader(ctx context.Context, height uint64) (*types.SignedHeader, error)
+	// GetHeaders returns signed headers of blocks in the given inclusive height range, ordered by height, without
+	// decoding block data.
+	GetHeaders(ctx context.Context, from, to uint64) ([]*types.SignedHeader, error)
 
 	// SaveBlockResponses saves block responses (events, tx responses, validator set updates, etc) in Store.
 	SaveBlockResponses(ctx context.Context, height uint64, responses *abci.ResponseFinalizeBlock) error
@@ -41,12 +58,24 @@ type Store interface {
 	// GetExtendedCommit returns extended commit (commit with vote extensions) for a block at given height.
 	GetExtendedCommit(ctx context.Context, height uint64) (*abci.ExtendedCommitInfo, error)
 
+	// SaveValidityProof saves validity proof of a block in Store.
+	SaveValidityProof(ctx context.Context, proof *types.ValidityProof) error
+
+	// GetValidityProof returns validity proof of a block at given height, or error if it's not found in Store.
+	GetValidityProof(ctx context.Context, height uint64) (*types.ValidityProof, error)
+
 	// UpdateState updates state saved in Store. Only one State is stored.
 	// If there is no State in Store, state will be saved.
 	UpdateState(ctx context.Context, state types.State) error
 	// GetState returns last state saved with UpdateState.
 	GetState(ctx context.Context) (types.State, error)
 
+	// GetConsensusParams returns consensus params in effect at given height, or error if they're not found in Store.
+	GetConsensusParams(ctx context.Context, height uint64) (*cmproto.ConsensusParams, error)
+
+	// GetValidators returns validator set in effect at given height, or error if it's not found in Store.
+	GetValidators(ctx context.Context, height uint64) (*cmtypes.ValidatorSet, error)
+
 	// SetMetadata saves arbitrary value in the store.
 	//
 	// This method enables rollkit to safely persist any information.
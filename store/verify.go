@@ -0,0 +1,211 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// VerifyIssue is a single problem found by Verify.
+type VerifyIssue struct {
+	// Height is the height of the affected block, or 0 for problems with the state.
+	Height uint64
+	Err    error
+	// Repaired is true if the problem was fixed.
+	Repaired bool
+}
+
+// VerifyReport is the result of store integrity verification.
+type VerifyReport struct {
+	FromHeight uint64
+	ToHeight   uint64
+	Issues     []VerifyIssue
+}
+
+// Repaired returns the number of repaired issues.
+func (r *VerifyReport) Repaired() int {
+	n := 0
+	for _, issue := range r.Issues {
+		if issue.Repaired {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *VerifyReport) addIssue(height uint64, err error, repaired bool) {
+	r.Issues = append(r.Issues, VerifyIssue{Height: height, Err: err, Repaired: repaired})
+}
+
+// Verify checks integrity of all blocks from the initial height up to the last block of the saved state.
+//
+// For every height, the height to hash index, the block (commit signature and data hash), the link to the previous
+// block (LastHeaderHash and LastCommitHash), the commit and the block responses are checked. Finally, the state is
+// checked against the last block. If repair is true, index entries that are missing or point to a wrong block are
+// rebuilt from the saved blocks.
+func (s *DefaultStore) Verify(ctx context.Context, repair bool) (*VerifyReport, error) {
+	state, err := s.GetState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report := &VerifyReport{FromHeight: state.InitialHeight, ToHeight: state.LastBlockHeight}
+	if report.FromHeight == 0 {
+		report.FromHeight = 1
+	}
+
+	// blocks by height, loaded only if index needs to be repaired
+	var blocks map[uint64]types.Hash
+	var prev *types.Block
+	for height := report.FromHeight; height <= report.ToHeight; height++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		block, indexErr := s.verifyIndex(ctx, height)
+		if indexErr != nil {
+			repaired := false
+			if repair {
+				if blocks == nil {
+					if blocks, err = s.blockHashesByHeight(ctx); err != nil {
+						return nil, err
+					}
+				}
+				block, repaired = s.repairIndex(ctx, height, blocks)
+			}
+			report.addIssue(height, indexErr, repaired)
+		}
+		if block == nil {
+			prev = nil
+			continue
+		}
+
+		for _, err := range s.verifyBlock(ctx, prev, block) {
+			report.addIssue(height, err, false)
+		}
+		prev = block
+	}
+
+	if prev == nil || prev.Height() != state.LastBlockHeight {
+		report.addIssue(0, fmt.Errorf("last block %d of the state is not available", state.LastBlockHeight), false)
+		return report, nil
+	}
+	if !bytes.Equal(state.LastBlockID.Hash, prev.Hash()) {
+		report.addIssue(0, fmt.Errorf("state last block hash %v doesn't match block hash %v", state.LastBlockID.Hash, prev.Hash()), false)
+	}
+	return report, nil
+}
+
+// verifyIndex checks that the index entry at given height points to a saved block at that height.
+func (s *DefaultStore) verifyIndex(ctx context.Context, height uint64) (*types.Block, error) {
+	hash, err := s.loadHashFromIndex(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	block, err := s.GetBlockByHash(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("indexed block %v: %w", hash, err)
+	}
+	if block.Height() != height {
+		return nil, fmt.Errorf("indexed block %v has height %d", hash, block.Height())
+	}
+	if !bytes.Equal(block.Hash(), hash) {
+		return nil, fmt.Errorf("indexed block %v has hash %v", hash, block.Hash())
+	}
+	return block, nil
+}
+
+// verifyBlock checks the block, its link to the previous block (if any), its commit and block responses.
+func (s *DefaultStore) verifyBlock(ctx context.Context, prev, block *types.Block) []error {
+	var errs []error
+	if err := block.SignedHeader.ValidateBasic(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid signed header: %w", err))
+	}
+	dataHash, err := block.Data.Hash()
+	if err != nil || !bytes.Equal(dataHash, block.SignedHeader.DataHash) {
+		errs = append(errs, errors.New("data hash doesn't match the block data"))
+	}
+	if prev != nil {
+		if err := prev.SignedHeader.Verify(&block.SignedHeader); err != nil {
+			errs = append(errs, fmt.Errorf("link to previous block: %w", err))
+		}
+	}
+	if err := s.verifyCommit(ctx, block); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := s.GetBlockResponses(ctx, block.Height()); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// verifyCommit checks that the commit saved with the block is the commit of the block.
+func (s *DefaultStore) verifyCommit(ctx context.Context, block *types.Block) error {
+	commit, err := s.GetCommitByHash(ctx, block.Hash())
+	if err != nil {
+		return err
+	}
+	equal, err := commitsEqual(commit, &block.SignedHeader.Commit)
+	if err != nil {
+		return err
+	}
+	if !equal {
+		return errors.New("saved commit doesn't match the block commit")
+	}
+	return nil
+}
+
+// commitsEqual returns true if both commits have the same encoding.
+func commitsEqual(a, b *types.Commit) (bool, error) {
+	aBytes, err := a.MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	bBytes, err := b.MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(aBytes, bBytes), nil
+}
+
+// repairIndex restores index entry at given height using the saved blocks.
+func (s *DefaultStore) repairIndex(ctx context.Context, height uint64, blocks map[uint64]types.Hash) (*types.Block, bool) {
+	hash, ok := blocks[height]
+	if !ok {
+		return nil, false
+	}
+	if err := s.db.Put(ctx, ds.NewKey(getIndexKey(height)), hash); err != nil {
+		return nil, false
+	}
+	block, err := s.GetBlockByHash(ctx, hash)
+	if err != nil {
+		return nil, false
+	}
+	return block, true
+}
+
+// blockHashesByHeight reads all saved blocks and returns their hashes by height.
+func (s *DefaultStore) blockHashesByHeight(ctx context.Context) (map[uint64]types.Hash, error) {
+	results, err := s.db.Query(ctx, dsq.Query{Prefix: GenerateKey([]string{blockPrefix})})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+
+	blocks := make(map[uint64]types.Hash)
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		block := new(types.Block)
+		if err := block.UnmarshalBinary(result.Value); err != nil {
+			continue
+		}
+		blocks[block.Height()] = block.Hash()
+	}
+	return blocks, nil
+}
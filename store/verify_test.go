@@ -0,0 +1,76 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv).(*DefaultStore)
+	blocks, _, state := saveTestChain(t, s, 5)
+
+	report, err := s.Verify(ctx, false)
+	require.NoError(err)
+	assert.Equal(uint64(1), report.FromHeight)
+	assert.Equal(uint64(5), report.ToHeight)
+	assert.Empty(report.Issues)
+
+	// missing and wrong index entries are reported, but not repaired
+	require.NoError(kv.Delete(ctx, ds.NewKey(getIndexKey(2))))
+	require.NoError(kv.Put(ctx, ds.NewKey(getIndexKey(3)), blocks[3].Hash()))
+	report, err = s.Verify(ctx, false)
+	require.NoError(err)
+	require.Len(report.Issues, 2)
+	assert.Equal(uint64(2), report.Issues[0].Height)
+	assert.ErrorIs(report.Issues[0].Err, ds.ErrNotFound)
+	assert.Equal(uint64(3), report.Issues[1].Height)
+	assert.Zero(report.Repaired())
+
+	// index is repaired from saved blocks
+	report, err = s.Verify(ctx, true)
+	require.NoError(err)
+	require.Len(report.Issues, 2)
+	assert.Equal(2, report.Repaired())
+	report, err = s.Verify(ctx, false)
+	require.NoError(err)
+	assert.Empty(report.Issues)
+
+	// missing block responses and inconsistent state can't be repaired
+	require.NoError(kv.Delete(ctx, ds.NewKey(getResponsesKey(4))))
+	state.LastBlockID.Hash = cmbytes.HexBytes(types.GetRandomBytes(32))
+	require.NoError(s.UpdateState(ctx, state))
+	report, err = s.Verify(ctx, true)
+	require.NoError(err)
+	require.Len(report.Issues, 2)
+	assert.Equal(uint64(4), report.Issues[0].Height)
+	assert.Equal(uint64(0), report.Issues[1].Height)
+	assert.Zero(report.Repaired())
+
+	// broken hash link is reported
+	require.NoError(s.SaveBlockResponses(ctx, 4, &abci.ResponseFinalizeBlock{}))
+	state.LastBlockID.Hash = cmbytes.HexBytes(blocks[4].Hash())
+	require.NoError(s.UpdateState(ctx, state))
+	other := *blocks[2]
+	other.SignedHeader.LastHeaderHash = types.GetRandomBytes(32)
+	require.NoError(s.SaveBlock(ctx, &other, &other.SignedHeader.Commit))
+	report, err = s.Verify(ctx, false)
+	require.NoError(err)
+	require.NotEmpty(report.Issues)
+	assert.Equal(uint64(3), report.Issues[0].Height)
+}
@@ -3,14 +3,19 @@
 package mocks
 
 import (
-	context "context"
-
 	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cometbfttypes "github.com/cometbft/cometbft/types"
+
+	context "context"
 
 	header "github.com/celestiaorg/go-header"
 
 	mock "github.com/stretchr/testify/mock"
 
+	tenderminttypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	time "time"
+
 	types "github.com/rollkit/rollkit/types"
 )
 
@@ -97,6 +102,36 @@ func (_m *Store) GetBlockByHash(ctx context.Context, hash header.Hash) (*types.B
 	return r0, r1
 }
 
+// GetBlockByTime provides a mock function with given fields: ctx, t
+func (_m *Store) GetBlockByTime(ctx context.Context, t time.Time) (*types.Block, error) {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockByTime")
+	}
+
+	var r0 *types.Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (*types.Block, error)); ok {
+		return rf(ctx, t)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) *types.Block); ok {
+		r0 = rf(ctx, t)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockResponses provides a mock function with given fields: ctx, height
 func (_m *Store) GetBlockResponses(ctx context.Context, height uint64) (*abcitypes.ResponseFinalizeBlock, error) {
 	ret := _m.Called(ctx, height)
@@ -127,6 +162,36 @@ func (_m *Store) GetBlockResponses(ctx context.Context, height uint64) (*abcityp
 	return r0, r1
 }
 
+// GetBlocks provides a mock function with given fields: ctx, from, to
+func (_m *Store) GetBlocks(ctx context.Context, from uint64, to uint64) ([]*types.Block, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlocks")
+	}
+
+	var r0 []*types.Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) ([]*types.Block, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) []*types.Block); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCommit provides a mock function with given fields: ctx, height
 func (_m *Store) GetCommit(ctx context.Context, height uint64) (*types.Commit, error) {
 	ret := _m.Called(ctx, height)
@@ -187,6 +252,36 @@ func (_m *Store) GetCommitByHash(ctx context.Context, hash header.Hash) (*types.
 	return r0, r1
 }
 
+// GetConsensusParams provides a mock function with given fields: ctx, height
+func (_m *Store) GetConsensusParams(ctx context.Context, height uint64) (*tenderminttypes.ConsensusParams, error) {
+	ret := _m.Called(ctx, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConsensusParams")
+	}
+
+	var r0 *tenderminttypes.ConsensusParams
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (*tenderminttypes.ConsensusParams, error)); ok {
+		return rf(ctx, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *tenderminttypes.ConsensusParams); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tenderminttypes.ConsensusParams)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetExtendedCommit provides a mock function with given fields: ctx, height
 func (_m *Store) GetExtendedCommit(ctx context.Context, height uint64) (*abcitypes.ExtendedCommitInfo, error) {
 	ret := _m.Called(ctx, height)
@@ -217,6 +312,66 @@ func (_m *Store) GetExtendedCommit(ctx context.Context, height uint64) (*abcityp
 	return r0, r1
 }
 
+// GetHeader provides a mock function with given fields: ctx, height
+func (_m *Store) GetHeader(ctx context.Context, height uint64) (*types.SignedHeader, error) {
+	ret := _m.Called(ctx, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHeader")
+	}
+
+	var r0 *types.SignedHeader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (*types.SignedHeader, error)); ok {
+		return rf(ctx, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *types.SignedHeader); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SignedHeader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHeaders provides a mock function with given fields: ctx, from, to
+func (_m *Store) GetHeaders(ctx context.Context, from uint64, to uint64) ([]*types.SignedHeader, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHeaders")
+	}
+
+	var r0 []*types.SignedHeader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) ([]*types.SignedHeader, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) []*types.SignedHeader); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.SignedHeader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMetadata provides a mock function with given fields: ctx, key
 func (_m *Store) GetMetadata(ctx context.Context, key string) ([]byte, error) {
 	ret := _m.Called(ctx, key)
@@ -275,6 +430,66 @@ func (_m *Store) GetState(ctx context.Context) (types.State, error) {
 	return r0, r1
 }
 
+// GetValidators provides a mock function with given fields: ctx, height
+func (_m *Store) GetValidators(ctx context.Context, height uint64) (*cometbfttypes.ValidatorSet, error) {
+	ret := _m.Called(ctx, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetValidators")
+	}
+
+	var r0 *cometbfttypes.ValidatorSet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (*cometbfttypes.ValidatorSet, error)); ok {
+		return rf(ctx, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *cometbfttypes.ValidatorSet); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cometbfttypes.ValidatorSet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetValidityProof provides a mock function with given fields: ctx, height
+func (_m *Store) GetValidityProof(ctx context.Context, height uint64) (*types.ValidityProof, error) {
+	ret := _m.Called(ctx, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetValidityProof")
+	}
+
+	var r0 *types.ValidityProof
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (*types.ValidityProof, error)); ok {
+		return rf(ctx, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *types.ValidityProof); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ValidityProof)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Height provides a mock function with given fields:
 func (_m *Store) Height() uint64 {
 	ret := _m.Called()
@@ -293,6 +508,24 @@ func (_m *Store) Height() uint64 {
 	return r0
 }
 
+// IterateBlocks provides a mock function with given fields: ctx, from, to, fn
+func (_m *Store) IterateBlocks(ctx context.Context, from uint64, to uint64, fn func(*types.Block) error) error {
+	ret := _m.Called(ctx, from, to, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterateBlocks")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, func(*types.Block) error) error); ok {
+		r0 = rf(ctx, from, to, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveBlock provides a mock function with given fields: ctx, block, commit
 func (_m *Store) SaveBlock(ctx context.Context, block *types.Block, commit *types.Commit) error {
 	ret := _m.Called(ctx, block, commit)
@@ -347,6 +580,24 @@ func (_m *Store) SaveExtendedCommit(ctx context.Context, height uint64, commit *
 	return r0
 }
 
+// SaveValidityProof provides a mock function with given fields: ctx, proof
+func (_m *Store) SaveValidityProof(ctx context.Context, proof *types.ValidityProof) error {
+	ret := _m.Called(ctx, proof)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveValidityProof")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ValidityProof) error); ok {
+		r0 = rf(ctx, proof)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetHeight provides a mock function with given fields: ctx, height
 func (_m *Store) SetHeight(ctx context.Context, height uint64) {
 	_m.Called(ctx, height)
@@ -93,9 +93,8 @@ func ToABCIBlock(block *types.Block) (*cmtypes.Block, error) {
 	}
 	abciBlock := cmtypes.Block{
 		Header: abciHeader,
-		Evidence: cmtypes.EvidenceData{
-			Evidence: nil,
-		},
+		// EvidenceHash is not updated, evidence is committed to by DataHash
+		Evidence:   block.Data.Evidence.ToCometBFT(),
 		LastCommit: abciCommit,
 	}
 	abciBlock.Data.Txs = make([]cmtypes.Tx, len(block.Data.Txs))
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding"
 	"errors"
+	"fmt"
 	"time"
 
 	cmbytes "github.com/cometbft/cometbft/libs/bytes"
@@ -33,8 +34,7 @@ var _ encoding.BinaryUnmarshaler = &Block{}
 type Data struct {
 	Txs Txs
 	// IntermediateStateRoots IntermediateStateRoots
-	// Note: Temporarily remove Evidence #896
-	// Evidence               EvidenceData
+	Evidence EvidenceData
 }
 
 // EvidenceData defines how evidence is stored in block.
@@ -95,8 +95,14 @@ func (c *Commit) GetCommitHash(header *Header, proposerAddress []byte) []byte {
 }
 
 // ValidateBasic performs basic validation of block data.
-// Actually it's a placeholder, because nothing is checked.
+//
+// Only the type of evidence is checked; evidence is verified against the validator set during block validation.
 func (d *Data) ValidateBasic() error {
+	for _, ev := range d.Evidence.Evidence {
+		if _, ok := ev.(*cmtypes.DuplicateVoteEvidence); !ok {
+			return fmt.Errorf("%w: %T", ErrUnsupportedEvidence, ev)
+		}
+	}
 	return nil
 }
 
@@ -112,9 +118,12 @@ func (c *Commit) ValidateBasic() error {
 }
 
 // ValidateBasic performs basic validation of a block.
+//
+// Time ahead of the local clock (ErrTimeTooFarInFuture) is reported only if the block is otherwise valid.
 func (b *Block) ValidateBasic() error {
-	if err := b.SignedHeader.ValidateBasic(); err != nil {
-		return err
+	timeErr := b.SignedHeader.ValidateBasic()
+	if timeErr != nil && !errors.Is(timeErr, ErrTimeTooFarInFuture) {
+		return timeErr
 	}
 	if err := b.Data.ValidateBasic(); err != nil {
 		return err
@@ -126,7 +135,7 @@ func (b *Block) ValidateBasic() error {
 	if !bytes.Equal(dataHash[:], b.SignedHeader.DataHash[:]) {
 		return errors.New("dataHash from the header does not match with hash of the block's data")
 	}
-	return nil
+	return timeErr
 }
 
 // New returns a new Block.
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+var (
+	// EncryptedTxPrefix marks transactions encrypted by clients in encrypted mempool mode.
+	EncryptedTxPrefix = []byte("rollkit/enctx/v1:")
+
+	// DecryptionKeysPrefix marks transaction revealing decryption keys of encrypted transactions in a block.
+	DecryptionKeysPrefix = []byte("rollkit/deckeys/v1:")
+
+	// ErrInvalidEncryptedTx is returned when encrypted transaction can't be decoded.
+	ErrInvalidEncryptedTx = errors.New("invalid encrypted transaction")
+
+	// ErrInvalidDecryptionKeys is returned when decryption keys can't be decoded.
+	ErrInvalidDecryptionKeys = errors.New("invalid decryption keys")
+)
+
+// EncryptedTx is a transaction encrypted by the client, so sequencer can't see its content before committing to the
+// ordering of transactions.
+type EncryptedTx struct {
+	// KeyID identifies the key used for encryption, e.g. an epoch or a time-lock round.
+	KeyID uint64
+	// Ciphertext is the encrypted transaction, in format defined by the key provider.
+	Ciphertext []byte
+}
+
+// IsEncryptedTx returns true if transaction is marked as encrypted.
+func IsEncryptedTx(tx []byte) bool {
+	return bytes.HasPrefix(tx, EncryptedTxPrefix)
+}
+
+// IsDecryptionKeysTx returns true if transaction reveals decryption keys.
+func IsDecryptionKeysTx(tx []byte) bool {
+	return bytes.HasPrefix(tx, DecryptionKeysPrefix)
+}
+
+// MarshalBinary encodes encrypted transaction into binary form.
+func (etx *EncryptedTx) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, len(EncryptedTxPrefix)+8+len(etx.Ciphertext))
+	buf = append(buf, EncryptedTxPrefix...)
+	buf = binary.BigEndian.AppendUint64(buf, etx.KeyID)
+	buf = append(buf, etx.Ciphertext...)
+	return buf, nil
+}
+
+// UnmarshalBinary decodes binary form of encrypted transaction.
+func (etx *EncryptedTx) UnmarshalBinary(tx []byte) error {
+	if !IsEncryptedTx(tx) || len(tx) <= len(EncryptedTxPrefix)+8 {
+		return ErrInvalidEncryptedTx
+	}
+	tx = tx[len(EncryptedTxPrefix):]
+	etx.KeyID = binary.BigEndian.Uint64(tx)
+	etx.Ciphertext = tx[8:]
+	return nil
+}
+
+// DecryptionKey is a key revealed after sequencer committed to the ordering of transactions.
+type DecryptionKey struct {
+	KeyID uint64
+	Key   []byte
+}
+
+// DecryptionKeys is a list of decryption keys revealed in a block.
+type DecryptionKeys []DecryptionKey
+
+// MarshalBinary encodes decryption keys into binary form.
+func (keys DecryptionKeys) MarshalBinary() ([]byte, error) {
+	buf := append([]byte{}, DecryptionKeysPrefix...)
+	for _, key := range keys {
+		buf = binary.BigEndian.AppendUint64(buf, key.KeyID)
+		buf = binary.AppendUvarint(buf, uint64(len(key.Key)))
+		buf = append(buf, key.Key...)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes binary form of decryption keys.
+func (keys *DecryptionKeys) UnmarshalBinary(tx []byte) error {
+	if !IsDecryptionKeysTx(tx) {
+		return ErrInvalidDecryptionKeys
+	}
+	tx = tx[len(DecryptionKeysPrefix):]
+	var decoded DecryptionKeys
+	for len(tx) > 0 {
+		if len(tx) < 8 {
+			return ErrInvalidDecryptionKeys
+		}
+		keyID := binary.BigEndian.Uint64(tx)
+		tx = tx[8:]
+		l, n := binary.Uvarint(tx)
+		if n <= 0 || uint64(len(tx)-n) < l {
+			return ErrInvalidDecryptionKeys
+		}
+		tx = tx[n:]
+		decoded = append(decoded, DecryptionKey{KeyID: keyID, Key: tx[:l]})
+		tx = tx[l:]
+	}
+	*keys = decoded
+	return nil
+}
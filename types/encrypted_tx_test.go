@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedTxSerialization(t *testing.T) {
+	require := require.New(t)
+
+	etx := EncryptedTx{KeyID: 42, Ciphertext: []byte("ciphertext")}
+	raw, err := etx.MarshalBinary()
+	require.NoError(err)
+	require.True(IsEncryptedTx(raw))
+
+	var decoded EncryptedTx
+	require.NoError(decoded.UnmarshalBinary(raw))
+	assert.Equal(t, etx, decoded)
+	require.ErrorIs(decoded.UnmarshalBinary([]byte("plain")), ErrInvalidEncryptedTx)
+
+	keys := DecryptionKeys{{KeyID: 1, Key: []byte("first")}, {KeyID: 2, Key: []byte("second")}}
+	raw, err = keys.MarshalBinary()
+	require.NoError(err)
+	require.True(IsDecryptionKeysTx(raw))
+
+	var decodedKeys DecryptionKeys
+	require.NoError(decodedKeys.UnmarshalBinary(raw))
+	assert.Equal(t, keys, decodedKeys)
+	require.ErrorIs(decodedKeys.UnmarshalBinary(raw[:len(raw)-1]), ErrInvalidDecryptionKeys)
+}
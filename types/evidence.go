@@ -0,0 +1,205 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtypes "github.com/cometbft/cometbft/types"
+)
+
+var (
+	// ErrUnsupportedEvidence is returned for evidence types not applicable to rollups.
+	ErrUnsupportedEvidence = errors.New("unsupported evidence type")
+
+	// ErrInvalidEvidence is returned when evidence doesn't prove misbehavior.
+	ErrInvalidEvidence = errors.New("invalid evidence")
+
+	// ErrEvidenceExpired is returned when evidence is too old to be included in a block.
+	ErrEvidenceExpired = errors.New("evidence expired")
+)
+
+// NewDuplicateVoteEvidence creates evidence of sequencer signing two different headers at the same height.
+//
+// Sequencer signs headers as CometBFT precommit votes (see Header.MakeCometBFTVote), so conflicting headers are
+// represented as DuplicateVoteEvidence, understood by ABCI applications.
+func NewDuplicateVoteEvidence(first, second *SignedHeader) (*cmtypes.DuplicateVoteEvidence, error) {
+	if first.Height() != second.Height() {
+		return nil, fmt.Errorf("%w: headers at different heights %d and %d", ErrInvalidEvidence, first.Height(), second.Height())
+	}
+	return cmtypes.NewDuplicateVoteEvidence(first.vote(), second.vote(), first.Time(), first.Validators)
+}
+
+// vote returns the precommit vote signed by the sequencer.
+func (sh *SignedHeader) vote() *cmtypes.Vote {
+	var signature []byte
+	if len(sh.Commit.Signatures) > 0 {
+		signature = sh.Commit.Signatures[0]
+	}
+	return &cmtypes.Vote{
+		Type:   cmproto.PrecommitType,
+		Height: int64(sh.Height()),
+		Round:  0,
+		BlockID: cmtypes.BlockID{
+			Hash: cmbytes.HexBytes(sh.Hash()),
+		},
+		Timestamp:        sh.Time(),
+		ValidatorAddress: sh.ProposerAddress,
+		ValidatorIndex:   0,
+		Signature:        signature,
+	}
+}
+
+// VerifyEvidence verifies that evidence proves misbehavior of a validator from valSet.
+//
+// Only DuplicateVoteEvidence is supported. Votes signed by the sequencer don't have part set headers, so
+// DuplicateVoteEvidence.ValidateBasic can't be used.
+func VerifyEvidence(evidence cmtypes.Evidence, chainID string, valSet *cmtypes.ValidatorSet) error {
+	switch ev := evidence.(type) {
+	case *cmtypes.DuplicateVoteEvidence:
+		return verifyDuplicateVote(ev, chainID, valSet)
+	default:
+		return fmt.Errorf("%w: %T", ErrUnsupportedEvidence, evidence)
+	}
+}
+
+func verifyDuplicateVote(ev *cmtypes.DuplicateVoteEvidence, chainID string, valSet *cmtypes.ValidatorSet) error {
+	if ev.VoteA == nil || ev.VoteB == nil {
+		return fmt.Errorf("%w: missing vote", ErrInvalidEvidence)
+	}
+	if valSet == nil {
+		return fmt.Errorf("%w: missing validator set", ErrInvalidEvidence)
+	}
+	_, val := valSet.GetByAddress(ev.VoteA.ValidatorAddress)
+	if val == nil {
+		return fmt.Errorf("%w: address %X is not a validator", ErrInvalidEvidence, ev.VoteA.ValidatorAddress)
+	}
+	if ev.VoteA.Height != ev.VoteB.Height || ev.VoteA.Round != ev.VoteB.Round || ev.VoteA.Type != ev.VoteB.Type {
+		return fmt.Errorf("%w: h/r/s does not match: %d/%d/%v vs %d/%d/%v", ErrInvalidEvidence,
+			ev.VoteA.Height, ev.VoteA.Round, ev.VoteA.Type, ev.VoteB.Height, ev.VoteB.Round, ev.VoteB.Type)
+	}
+	if !bytes.Equal(ev.VoteA.ValidatorAddress, ev.VoteB.ValidatorAddress) {
+		return fmt.Errorf("%w: validator addresses do not match: %X vs %X", ErrInvalidEvidence,
+			ev.VoteA.ValidatorAddress, ev.VoteB.ValidatorAddress)
+	}
+	if strings.Compare(ev.VoteA.BlockID.Key(), ev.VoteB.BlockID.Key()) != -1 {
+		return fmt.Errorf("%w: duplicate votes in invalid order", ErrInvalidEvidence)
+	}
+	if val.VotingPower != ev.ValidatorPower || valSet.TotalVotingPower() != ev.TotalVotingPower {
+		return fmt.Errorf("%w: voting power does not match validator set", ErrInvalidEvidence)
+	}
+	if !val.PubKey.VerifySignature(cmtypes.VoteSignBytes(chainID, ev.VoteA.ToProto()), ev.VoteA.Signature) {
+		return fmt.Errorf("%w: verifying VoteA: %w", ErrInvalidEvidence, cmtypes.ErrVoteInvalidSignature)
+	}
+	if !val.PubKey.VerifySignature(cmtypes.VoteSignBytes(chainID, ev.VoteB.ToProto()), ev.VoteB.Signature) {
+		return fmt.Errorf("%w: verifying VoteB: %w", ErrInvalidEvidence, cmtypes.ErrVoteInvalidSignature)
+	}
+	return nil
+}
+
+// IsEvidenceExpired returns true if evidence is older than both MaxAgeNumBlocks and MaxAgeDuration of evidence params,
+// relative to the block with given height and time (as in CometBFT).
+func IsEvidenceExpired(evidence cmtypes.Evidence, height uint64, blockTime time.Time, params *cmproto.EvidenceParams) bool {
+	if params == nil {
+		return false
+	}
+	ageNumBlocks := int64(height) - evidence.Height()
+	ageDuration := blockTime.Sub(evidence.Time())
+	return ageNumBlocks > params.MaxAgeNumBlocks && ageDuration > params.MaxAgeDuration
+}
+
+// ToABCI returns evidence in the format passed to ABCI applications.
+func (ed *EvidenceData) ToABCI() []abci.Misbehavior {
+	var misbehavior []abci.Misbehavior
+	for _, ev := range ed.Evidence {
+		misbehavior = append(misbehavior, ev.ABCI()...)
+	}
+	return misbehavior
+}
+
+// ToCometBFT returns evidence in the format used by CometBFT blocks.
+func (ed *EvidenceData) ToCometBFT() cmtypes.EvidenceData {
+	return cmtypes.EvidenceData{Evidence: cmtypes.EvidenceList(ed.Evidence)}
+}
+
+// EvidenceFromProto converts evidence from protobuf representation.
+//
+// Unlike cmtypes.EvidenceFromProto, evidence is not validated, as votes signed by the sequencer don't pass
+// CometBFT vote validation; use VerifyEvidence instead.
+func EvidenceFromProto(evidence *cmproto.Evidence) (cmtypes.Evidence, error) {
+	if evidence == nil {
+		return nil, errors.New("nil evidence")
+	}
+	dve := evidence.GetDuplicateVoteEvidence()
+	if dve == nil {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedEvidence, evidence.Sum)
+	}
+	voteA, err := voteFromProto(dve.VoteA)
+	if err != nil {
+		return nil, err
+	}
+	voteB, err := voteFromProto(dve.VoteB)
+	if err != nil {
+		return nil, err
+	}
+	return &cmtypes.DuplicateVoteEvidence{
+		VoteA:            voteA,
+		VoteB:            voteB,
+		TotalVotingPower: dve.TotalVotingPower,
+		ValidatorPower:   dve.ValidatorPower,
+		Timestamp:        dve.Timestamp,
+	}, nil
+}
+
+func voteFromProto(pv *cmproto.Vote) (*cmtypes.Vote, error) {
+	if pv == nil {
+		return nil, errors.New("nil vote")
+	}
+	blockID, err := cmtypes.BlockIDFromProto(&pv.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	return &cmtypes.Vote{
+		Type:               pv.Type,
+		Height:             pv.Height,
+		Round:              pv.Round,
+		BlockID:            *blockID,
+		Timestamp:          pv.Timestamp,
+		ValidatorAddress:   pv.ValidatorAddress,
+		ValidatorIndex:     pv.ValidatorIndex,
+		Signature:          pv.Signature,
+		Extension:          pv.Extension,
+		ExtensionSignature: pv.ExtensionSignature,
+	}, nil
+}
+
+// evidenceToProto converts evidence into protobuf representation. Evidence that can't be converted (nil or of unknown
+// type) is skipped; such evidence is rejected by Data.ValidateBasic.
+func evidenceToProto(evidence EvidenceData) []*cmproto.Evidence {
+	var ret []*cmproto.Evidence
+	for _, ev := range evidence.Evidence {
+		pev, err := cmtypes.EvidenceToProto(ev)
+		if err != nil {
+			continue
+		}
+		ret = append(ret, pev)
+	}
+	return ret
+}
+
+func evidenceFromProto(evidence []*cmproto.Evidence) (EvidenceData, error) {
+	var ret EvidenceData
+	for _, pev := range evidence {
+		ev, err := EvidenceFromProto(pev)
+		if err != nil {
+			return EvidenceData{}, err
+		}
+		ret.Evidence = append(ret.Evidence, ev)
+	}
+	return ret, nil
+}
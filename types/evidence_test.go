@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getConflictingHeaders(t *testing.T, height uint64) (*SignedHeader, *SignedHeader) {
+	privKey := ed25519.GenPrivKey()
+	first, err := GetRandomSignedHeaderCustom(&HeaderConfig{Height: height, DataHash: GetRandomBytes(32), PrivKey: privKey})
+	require.NoError(t, err)
+	second, err := GetRandomSignedHeaderCustom(&HeaderConfig{Height: height, DataHash: GetRandomBytes(32), PrivKey: privKey})
+	require.NoError(t, err)
+	return first, second
+}
+
+func TestDuplicateVoteEvidence(t *testing.T) {
+	first, second := getConflictingHeaders(t, 10)
+
+	ev, err := NewDuplicateVoteEvidence(first, second)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), ev.Height())
+	assert.NoError(t, VerifyEvidence(ev, TestChainID, first.Validators))
+
+	assert.ErrorIs(t, VerifyEvidence(ev, "other-chain", first.Validators), ErrInvalidEvidence)
+	assert.ErrorIs(t, VerifyEvidence(ev, TestChainID, GetRandomValidatorSet()), ErrInvalidEvidence)
+	assert.ErrorIs(t, VerifyEvidence(&cmtypes.LightClientAttackEvidence{}, TestChainID, first.Validators), ErrUnsupportedEvidence)
+
+	// votes of the same header are not a misbehavior
+	ev.VoteB = ev.VoteA
+	assert.ErrorIs(t, VerifyEvidence(ev, TestChainID, first.Validators), ErrInvalidEvidence)
+
+	other, err := GetRandomSignedHeaderCustom(&HeaderConfig{Height: 11, DataHash: GetRandomBytes(32), PrivKey: ed25519.GenPrivKey()})
+	require.NoError(t, err)
+	_, err = NewDuplicateVoteEvidence(first, other)
+	assert.ErrorIs(t, err, ErrInvalidEvidence)
+}
+
+func TestDataWithEvidenceRoundTrip(t *testing.T) {
+	first, second := getConflictingHeaders(t, 5)
+	ev, err := NewDuplicateVoteEvidence(first, second)
+	require.NoError(t, err)
+
+	data := &Data{Txs: Txs{GetRandomTx()}, Evidence: EvidenceData{Evidence: []cmtypes.Evidence{ev}}}
+	require.NoError(t, data.ValidateBasic())
+	bytes, err := data.MarshalBinary()
+	require.NoError(t, err)
+
+	var decoded Data
+	require.NoError(t, decoded.UnmarshalBinary(bytes))
+	require.Len(t, decoded.Evidence.Evidence, 1)
+	assert.Equal(t, ev.Hash(), decoded.Evidence.Evidence[0].Hash())
+	assert.NoError(t, VerifyEvidence(decoded.Evidence.Evidence[0], TestChainID, first.Validators))
+
+	misbehavior := decoded.Evidence.ToABCI()
+	require.Len(t, misbehavior, 1)
+	assert.Equal(t, int64(5), misbehavior[0].Height)
+
+	data.Evidence.Evidence = []cmtypes.Evidence{&cmtypes.LightClientAttackEvidence{}}
+	assert.Error(t, data.ValidateBasic())
+}
@@ -23,8 +23,20 @@ var (
 
 	// ErrProposerVerificationFailed is returned when the proposer verification fails.
 	ErrProposerVerificationFailed = errors.New("proposer verification failed")
+
+	// ErrNoTime is returned when the time is not set.
+	ErrNoTime = errors.New("no time")
+
+	// ErrTimeTooFarInFuture is returned when the time is ahead of the local clock by more than MaxClockDrift.
+	ErrTimeTooFarInFuture = errors.New("time too far in the future")
+
+	// ErrNonMonotonicTime is returned when the time is not after the time of the previous header.
+	ErrNonMonotonicTime = errors.New("time is not after the time of the previous header")
 )
 
+// MaxClockDrift is the maximal allowed difference between the header time and the local clock.
+const MaxClockDrift = 10 * time.Second
+
 // BaseHeader contains the most basic data of a header
 type BaseHeader struct {
 	// Height represents the block height (aka block number) of a given header
@@ -106,6 +118,15 @@ func (h *Header) Verify(untrstH *Header) error {
 			),
 		}
 	}
+	if !untrstH.Time().After(h.Time()) {
+		return &header.VerifyError{
+			Reason: fmt.Errorf("%w: trusted %s, untrusted %s",
+				ErrNonMonotonicTime,
+				h.Time(),
+				untrstH.Time(),
+			),
+		}
+	}
 	return nil
 }
 
@@ -120,6 +141,14 @@ func (h *Header) ValidateBasic() error {
 		return ErrNoProposerAddress
 	}
 
+	if h.BaseHeader.Time == 0 {
+		return ErrNoTime
+	}
+
+	if drift := time.Until(h.Time()); drift > MaxClockDrift {
+		return fmt.Errorf("%w: %s ahead of local clock", ErrTimeTooFarInFuture, drift)
+	}
+
 	return nil
 }
 
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmtypes "github.com/cometbft/cometbft/types"
 )
 
@@ -75,3 +77,15 @@ func ConsensusParamsValidateBasic(params cmtypes.ConsensusParams) error {
 
 	return nil
 }
+
+// ConsensusHash returns the hash of consensus params, committed to in Header.ConsensusHash.
+//
+// Unlike cmtypes.ConsensusParams.Hash, which covers only block params, all consensus params are hashed, so any
+// update made by the application is reflected in the header.
+func ConsensusHash(params cmproto.ConsensusParams) (Hash, error) {
+	bz, err := params.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return tmhash.Sum(bz), nil
+}
@@ -5,6 +5,7 @@ import (
 
 	cmtypes "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConsensusParamsValidateBasic(t *testing.T) {
@@ -141,3 +142,20 @@ func TestConsensusParamsValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestConsensusHash(t *testing.T) {
+	params := cmtypes.DefaultConsensusParams().ToProto()
+	hash, err := ConsensusHash(params)
+	require.NoError(t, err)
+	assert.Len(t, hash, 32)
+
+	same, err := ConsensusHash(cmtypes.DefaultConsensusParams().ToProto())
+	require.NoError(t, err)
+	assert.Equal(t, hash, same)
+
+	// params not covered by cmtypes.ConsensusParams.Hash are also committed to
+	params.Evidence.MaxBytes++
+	changed, err := ConsensusHash(params)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+}
@@ -0,0 +1,2011 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollkit/execution.proto
+
+package rollkit
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type InitChainRequest struct {
+	GenesisTime   time.Time `protobuf:"bytes,1,opt,name=genesis_time,json=genesisTime,proto3,stdtime" json:"genesis_time"`
+	InitialHeight uint64    `protobuf:"varint,2,opt,name=initial_height,json=initialHeight,proto3" json:"initial_height,omitempty"`
+	ChainId       string    `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AppState      []byte    `protobuf:"bytes,4,opt,name=app_state,json=appState,proto3" json:"app_state,omitempty"`
+}
+
+func (m *InitChainRequest) Reset()         { *m = InitChainRequest{} }
+func (m *InitChainRequest) String() string { return proto.CompactTextString(m) }
+func (*InitChainRequest) ProtoMessage()    {}
+func (*InitChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a22f6063ef2219be, []int{0}
+}
+func (m *InitChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InitChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InitChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InitChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitChainRequest.Merge(m, src)
+}
+func (m *InitChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InitChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitChainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitChainRequest proto.InternalMessageInfo
+
+func (m *InitChainRequest) GetGenesisTime() time.Time {
+	if m != nil {
+		return m.GenesisTime
+	}
+	return time.Time{}
+}
+
+func (m *InitChainRequest) GetInitialHeight() uint64 {
+	if m != nil {
+		return m.InitialHeight
+	}
+	return 0
+}
+
+func (m *InitChainRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *InitChainRequest) GetAppState() []byte {
+	if m != nil {
+		return m.AppState
+	}
+	return nil
+}
+
+type InitChainResponse struct {
+	StateRoot []byte `protobuf:"bytes,1,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	MaxBytes  uint64 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+}
+
+func (m *InitChainResponse) Reset()         { *m = InitChainResponse{} }
+func (m *InitChainResponse) String() string { return proto.CompactTextString(m) }
+func (*InitChainResponse) ProtoMessage()    {}
+func (*InitChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a22f6063ef2219be, []int{1}
+}
+func (m *InitChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InitChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InitChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InitChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitChainResponse.Merge(m, src)
+}
+func (m *InitChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InitChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitChainResponse proto.InternalMessageInfo
+
+func (m *InitChainResponse) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *InitChainResponse) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+type GetTxsRequest struct {
+	MaxBytes uint64 `protobuf:"varint,1,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+}
+
+func (m *GetTxsRequest) Reset()         { *m = GetTxsRequest{} }
+func (m *GetTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTxsRequest) ProtoMessage()    {}
+func (*GetTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a22f6063ef2219be, []int{2}
+}
+func (m *GetTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxsRequest.Merge(m, src)
+}
+func (m *GetTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxsRequest proto.InternalMessageInfo
+
+func (m *GetTxsRequest) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+type GetTxsResponse struct {
+	Txs [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *GetTxsResponse) Reset()         { *m = GetTxsResponse{} }
+func (m *GetTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxsResponse) ProtoMessage()    {}
+func (*GetTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a22f6063ef2219be, []int{3}
+}
+func (m *GetTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxsResponse.Merge(m, src)
+}
+func (m *GetTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxsResponse proto.InternalMessageInfo
+
+func (m *GetTxsResponse) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+type ExecuteTxsRequest struct {
+	Txs           [][]byte  `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	Height        uint64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp     time.Time `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	PrevStateRoot []byte    `protobuf:"bytes,4,opt,name=prev_state_root,json=prevStateRoot,proto3" json:"prev_state_root,omitempty"`
+}
+
+func (m *ExecuteTxsRequest) Reset()         { *m = ExecuteTxsRequest{} }
+func (m *ExecuteTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecuteTxsRequest) ProtoMessage()    {}
+func (*ExecuteTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a22f6063ef2219be, []int{4}
+}
+func (m *ExecuteTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecuteTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteTxsRequest.Merge(m, src)
+}
+func (m *ExecuteTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteTxsRequest proto.InternalMessageInfo
+
+func (m *ExecuteTxsRequest) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *ExecuteTxsRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExecuteTxsRequest) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *ExecuteTxsRequest) GetPrevStateRoot() []byte {
+	if m != nil {
+		return m.PrevStateRoot
+	}
+	return nil
+}
+
+type ExecuteTxsResponse struct {
+	UpdatedStateRoot []byte `protobuf:"bytes,1,opt,name=updated_state_root,json=updatedStateRoot,proto3" json:"updated_state_root,omitempty"`
+	MaxBytes         uint64 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+}
+
+func (m *ExecuteTxsResponse) Reset()         { *m = ExecuteTxsResponse{} }
+func (m *ExecuteTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecuteTxsResponse) ProtoMessage()    {}
+func (*ExecuteTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a22f6063ef2219be, []int{5}
+}
+func (m *ExecuteTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecuteTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteTxsResponse.Merge(m, src)
+}
+func (m *ExecuteTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteTxsResponse proto.InternalMessageInfo
+
+func (m *ExecuteTxsResponse) GetUpdatedStateRoot() []byte {
+	if m != nil {
+		return m.UpdatedStateRoot
+	}
+	return nil
+}
+
+func (m *ExecuteTxsResponse) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+type SetFinalRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SetFinalRequest) Reset()         { *m = SetFinalRequest{} }
+func (m *SetFinalRequest) String() string { return proto.CompactTextString(m) }
+func (*SetFinalRequest) ProtoMessage()    {}
+func (*SetFinalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a22f6063ef2219be, []int{6}
+}
+func (m *SetFinalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetFinalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetFinalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetFinalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFinalRequest.Merge(m, src)
+}
+func (m *SetFinalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetFinalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFinalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFinalRequest proto.InternalMessageInfo
+
+func (m *SetFinalRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type SetFinalResponse struct {
+}
+
+func (m *SetFinalResponse) Reset()         { *m = SetFinalResponse{} }
+func (m *SetFinalResponse) String() string { return proto.CompactTextString(m) }
+func (*SetFinalResponse) ProtoMessage()    {}
+func (*SetFinalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a22f6063ef2219be, []int{7}
+}
+func (m *SetFinalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetFinalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetFinalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetFinalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFinalResponse.Merge(m, src)
+}
+func (m *SetFinalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetFinalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFinalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFinalResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*InitChainRequest)(nil), "rollkit.InitChainRequest")
+	proto.RegisterType((*InitChainResponse)(nil), "rollkit.InitChainResponse")
+	proto.RegisterType((*GetTxsRequest)(nil), "rollkit.GetTxsRequest")
+	proto.RegisterType((*GetTxsResponse)(nil), "rollkit.GetTxsResponse")
+	proto.RegisterType((*ExecuteTxsRequest)(nil), "rollkit.ExecuteTxsRequest")
+	proto.RegisterType((*ExecuteTxsResponse)(nil), "rollkit.ExecuteTxsResponse")
+	proto.RegisterType((*SetFinalRequest)(nil), "rollkit.SetFinalRequest")
+	proto.RegisterType((*SetFinalResponse)(nil), "rollkit.SetFinalResponse")
+}
+
+func init() { proto.RegisterFile("rollkit/execution.proto", fileDescriptor_a22f6063ef2219be) }
+
+var fileDescriptor_a22f6063ef2219be = []byte{
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0x36, 0x55, 0x9b, 0x4c, 0x93, 0x26, 0x5d, 0xa1, 0xd6, 0x71, 0x85, 0x13, 0x59, 0x02,
+	0x05, 0x51, 0xd9, 0x52, 0x39, 0x72, 0x22, 0x50, 0x42, 0x4f, 0x48, 0x4e, 0x4f, 0x5c, 0x2c, 0x27,
+	0x59, 0x9c, 0x15, 0x89, 0x77, 0xb1, 0x27, 0x55, 0xfa, 0x16, 0x7d, 0x04, 0xae, 0xbc, 0x02, 0x4f,
+	0xd0, 0x63, 0x8f, 0x9c, 0x00, 0x25, 0x2f, 0x82, 0xfc, 0xb3, 0xce, 0xef, 0x01, 0x4e, 0xd9, 0x99,
+	0xef, 0xdb, 0x6f, 0x67, 0xbe, 0x99, 0x18, 0xce, 0x42, 0x31, 0x1e, 0x7f, 0xe1, 0x68, 0xb3, 0x19,
+	0x1b, 0x4c, 0x91, 0x8b, 0xc0, 0x92, 0xa1, 0x40, 0x41, 0x0f, 0x33, 0x40, 0x7f, 0xe2, 0x0b, 0x5f,
+	0x24, 0x39, 0x3b, 0x3e, 0xa5, 0xb0, 0xde, 0xf4, 0x85, 0xf0, 0xc7, 0xcc, 0x4e, 0xa2, 0xfe, 0xf4,
+	0xb3, 0x8d, 0x7c, 0xc2, 0x22, 0xf4, 0x26, 0x32, 0x25, 0x98, 0x3f, 0x08, 0xd4, 0xaf, 0x03, 0x8e,
+	0x6f, 0x47, 0x1e, 0x0f, 0x1c, 0xf6, 0x75, 0xca, 0x22, 0xa4, 0x5d, 0xa8, 0xf8, 0x2c, 0x60, 0x11,
+	0x8f, 0xdc, 0x98, 0xaf, 0x91, 0x16, 0x69, 0x1f, 0x5d, 0xea, 0x56, 0x2a, 0x66, 0x29, 0x31, 0xeb,
+	0x46, 0x89, 0x75, 0x4a, 0x0f, 0xbf, 0x9a, 0x85, 0xfb, 0xdf, 0x4d, 0xe2, 0x1c, 0x65, 0x37, 0x63,
+	0x8c, 0x3e, 0x83, 0x63, 0x1e, 0x70, 0xe4, 0xde, 0xd8, 0x1d, 0x31, 0xee, 0x8f, 0x50, 0xdb, 0x6b,
+	0x91, 0xf6, 0xbe, 0x53, 0xcd, 0xb2, 0x1f, 0x92, 0x24, 0x6d, 0x40, 0x69, 0x10, 0xbf, 0xef, 0xf2,
+	0xa1, 0x56, 0x6c, 0x91, 0x76, 0xd9, 0x39, 0x4c, 0xe2, 0xeb, 0x21, 0x3d, 0x87, 0xb2, 0x27, 0xa5,
+	0x1b, 0xa1, 0x87, 0x4c, 0xdb, 0x6f, 0x91, 0x76, 0xc5, 0x29, 0x79, 0x52, 0xf6, 0xe2, 0xd8, 0xfc,
+	0x08, 0x27, 0x2b, 0xb5, 0x47, 0x52, 0x04, 0x11, 0xa3, 0x4f, 0x01, 0x12, 0xb6, 0x1b, 0x0a, 0x81,
+	0x49, 0xe9, 0x15, 0xa7, 0x9c, 0x64, 0x1c, 0x21, 0x30, 0x16, 0x9c, 0x78, 0x33, 0xb7, 0x7f, 0x87,
+	0x2c, 0xca, 0xaa, 0x29, 0x4d, 0xbc, 0x59, 0x27, 0x8e, 0xcd, 0x0b, 0xa8, 0x76, 0x19, 0xde, 0xcc,
+	0x22, 0xe5, 0xc4, 0x1a, 0x9b, 0x6c, 0xb0, 0x4d, 0x38, 0x56, 0xec, 0xec, 0xed, 0x3a, 0x14, 0x71,
+	0x16, 0x13, 0x8b, 0xed, 0x8a, 0x13, 0x1f, 0xcd, 0xef, 0x04, 0x4e, 0xae, 0x92, 0x99, 0xb1, 0x15,
+	0xd9, 0x2d, 0x1e, 0x3d, 0x85, 0x83, 0x35, 0x87, 0xb2, 0x88, 0x76, 0xa0, 0x9c, 0x8f, 0x4c, 0x2b,
+	0xfe, 0xc7, 0x1c, 0x96, 0xd7, 0xe8, 0x73, 0xa8, 0xc9, 0x90, 0xdd, 0xba, 0x2b, 0xb6, 0xa4, 0x4e,
+	0x56, 0xe3, 0x74, 0x4f, 0x59, 0x63, 0xba, 0x40, 0x57, 0x4b, 0xcd, 0x7a, 0xba, 0x00, 0x3a, 0x95,
+	0x43, 0x0f, 0xd9, 0xd0, 0xdd, 0xf2, 0xb5, 0x9e, 0x21, 0xbd, 0x7f, 0xb3, 0xf7, 0x05, 0xd4, 0x7a,
+	0x0c, 0xdf, 0xf3, 0xc0, 0x1b, 0x2b, 0x27, 0x96, 0x7d, 0x93, 0xd5, 0xbe, 0x4d, 0x0a, 0xf5, 0x25,
+	0x35, 0xad, 0xe4, 0xf2, 0xdb, 0x1e, 0xd4, 0xd2, 0x02, 0x45, 0xd8, 0x63, 0xe1, 0x2d, 0x1f, 0x30,
+	0xfa, 0x0e, 0xca, 0xf9, 0x0a, 0xd0, 0x86, 0x95, 0xfd, 0x1b, 0xac, 0xcd, 0x95, 0xd6, 0xf5, 0x5d,
+	0x50, 0xaa, 0x6b, 0x16, 0xe8, 0x6b, 0x38, 0x48, 0x27, 0x49, 0x4f, 0x73, 0xde, 0xda, 0x22, 0xe8,
+	0x67, 0x5b, 0xf9, 0xfc, 0x72, 0x17, 0x60, 0x69, 0x1b, 0x5d, 0x3e, 0xb4, 0x35, 0x76, 0xfd, 0x7c,
+	0x27, 0x96, 0x0b, 0xbd, 0x81, 0x92, 0xea, 0x99, 0x6a, 0x39, 0x75, 0xc3, 0x31, 0xbd, 0xb1, 0x03,
+	0x51, 0x12, 0x9d, 0xab, 0x87, 0xb9, 0x41, 0x1e, 0xe7, 0x06, 0xf9, 0x33, 0x37, 0xc8, 0xfd, 0xc2,
+	0x28, 0x3c, 0x2e, 0x8c, 0xc2, 0xcf, 0x85, 0x51, 0xf8, 0xf4, 0xd2, 0xe7, 0x38, 0x9a, 0xf6, 0xad,
+	0x81, 0x98, 0xd8, 0xea, 0x63, 0xa2, 0x7e, 0xf1, 0x4e, 0xb2, 0xc8, 0x96, 0x7d, 0x95, 0xe8, 0x1f,
+	0x24, 0xab, 0xf5, 0xea, 0xef, 0x00, 0xc7, 0x37, 0x6f, 0xc6, 0x77, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ExecutorServiceClient is the client API for ExecutorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ExecutorServiceClient interface {
+	// InitChain initializes the state machine with genesis.
+	InitChain(ctx context.Context, in *InitChainRequest, opts ...grpc.CallOption) (*InitChainResponse, error)
+	// GetTxs returns transactions to be included in the next block.
+	GetTxs(ctx context.Context, in *GetTxsRequest, opts ...grpc.CallOption) (*GetTxsResponse, error)
+	// ExecuteTxs executes transactions of the block.
+	ExecuteTxs(ctx context.Context, in *ExecuteTxsRequest, opts ...grpc.CallOption) (*ExecuteTxsResponse, error)
+	// SetFinal marks the block as final.
+	SetFinal(ctx context.Context, in *SetFinalRequest, opts ...grpc.CallOption) (*SetFinalResponse, error)
+}
+
+type executorServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewExecutorServiceClient(cc *grpc.ClientConn) ExecutorServiceClient {
+	return &executorServiceClient{cc}
+}
+
+func (c *executorServiceClient) InitChain(ctx context.Context, in *InitChainRequest, opts ...grpc.CallOption) (*InitChainResponse, error) {
+	out := new(InitChainResponse)
+	err := c.cc.Invoke(ctx, "/rollkit.ExecutorService/InitChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorServiceClient) GetTxs(ctx context.Context, in *GetTxsRequest, opts ...grpc.CallOption) (*GetTxsResponse, error) {
+	out := new(GetTxsResponse)
+	err := c.cc.Invoke(ctx, "/rollkit.ExecutorService/GetTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorServiceClient) ExecuteTxs(ctx context.Context, in *ExecuteTxsRequest, opts ...grpc.CallOption) (*ExecuteTxsResponse, error) {
+	out := new(ExecuteTxsResponse)
+	err := c.cc.Invoke(ctx, "/rollkit.ExecutorService/ExecuteTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorServiceClient) SetFinal(ctx context.Context, in *SetFinalRequest, opts ...grpc.CallOption) (*SetFinalResponse, error) {
+	out := new(SetFinalResponse)
+	err := c.cc.Invoke(ctx, "/rollkit.ExecutorService/SetFinal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExecutorServiceServer is the server API for ExecutorService service.
+type ExecutorServiceServer interface {
+	// InitChain initializes the state machine with genesis.
+	InitChain(context.Context, *InitChainRequest) (*InitChainResponse, error)
+	// GetTxs returns transactions to be included in the next block.
+	GetTxs(context.Context, *GetTxsRequest) (*GetTxsResponse, error)
+	// ExecuteTxs executes transactions of the block.
+	ExecuteTxs(context.Context, *ExecuteTxsRequest) (*ExecuteTxsResponse, error)
+	// SetFinal marks the block as final.
+	SetFinal(context.Context, *SetFinalRequest) (*SetFinalResponse, error)
+}
+
+// UnimplementedExecutorServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedExecutorServiceServer struct {
+}
+
+func (*UnimplementedExecutorServiceServer) InitChain(ctx context.Context, req *InitChainRequest) (*InitChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitChain not implemented")
+}
+func (*UnimplementedExecutorServiceServer) GetTxs(ctx context.Context, req *GetTxsRequest) (*GetTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxs not implemented")
+}
+func (*UnimplementedExecutorServiceServer) ExecuteTxs(ctx context.Context, req *ExecuteTxsRequest) (*ExecuteTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteTxs not implemented")
+}
+func (*UnimplementedExecutorServiceServer) SetFinal(ctx context.Context, req *SetFinalRequest) (*SetFinalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFinal not implemented")
+}
+
+func RegisterExecutorServiceServer(s *grpc.Server, srv ExecutorServiceServer) {
+	s.RegisterService(&_ExecutorService_serviceDesc, srv)
+}
+
+func _ExecutorService_InitChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServiceServer).InitChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollkit.ExecutorService/InitChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServiceServer).InitChain(ctx, req.(*InitChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorService_GetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServiceServer).GetTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollkit.ExecutorService/GetTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServiceServer).GetTxs(ctx, req.(*GetTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorService_ExecuteTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServiceServer).ExecuteTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollkit.ExecutorService/ExecuteTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServiceServer).ExecuteTxs(ctx, req.(*ExecuteTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorService_SetFinal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFinalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServiceServer).SetFinal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollkit.ExecutorService/SetFinal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServiceServer).SetFinal(ctx, req.(*SetFinalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ExecutorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rollkit.ExecutorService",
+	HandlerType: (*ExecutorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InitChain",
+			Handler:    _ExecutorService_InitChain_Handler,
+		},
+		{
+			MethodName: "GetTxs",
+			Handler:    _ExecutorService_GetTxs_Handler,
+		},
+		{
+			MethodName: "ExecuteTxs",
+			Handler:    _ExecutorService_ExecuteTxs_Handler,
+		},
+		{
+			MethodName: "SetFinal",
+			Handler:    _ExecutorService_SetFinal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rollkit/execution.proto",
+}
+
+func (m *InitChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InitChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppState) > 0 {
+		i -= len(m.AppState)
+		copy(dAtA[i:], m.AppState)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.AppState)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InitialHeight != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.InitialHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.GenesisTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.GenesisTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintExecution(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InitChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InitChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBytes != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBytes != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintExecution(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecuteTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PrevStateRoot) > 0 {
+		i -= len(m.PrevStateRoot)
+		copy(dAtA[i:], m.PrevStateRoot)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.PrevStateRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintExecution(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintExecution(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecuteTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBytes != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UpdatedStateRoot) > 0 {
+		i -= len(m.UpdatedStateRoot)
+		copy(dAtA[i:], m.UpdatedStateRoot)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.UpdatedStateRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetFinalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetFinalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetFinalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetFinalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetFinalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetFinalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExecution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExecution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InitChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.GenesisTime)
+	n += 1 + l + sovExecution(uint64(l))
+	if m.InitialHeight != 0 {
+		n += 1 + sovExecution(uint64(m.InitialHeight))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.AppState)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *InitChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovExecution(uint64(m.MaxBytes))
+	}
+	return n
+}
+
+func (m *GetTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBytes != 0 {
+		n += 1 + sovExecution(uint64(m.MaxBytes))
+	}
+	return n
+}
+
+func (m *GetTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecuteTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovExecution(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovExecution(uint64(l))
+	l = len(m.PrevStateRoot)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecuteTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpdatedStateRoot)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovExecution(uint64(m.MaxBytes))
+	}
+	return n
+}
+
+func (m *SetFinalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovExecution(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SetFinalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovExecution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExecution(x uint64) (n int) {
+	return sovExecution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InitChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.GenesisTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			}
+			m.InitialHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppState = append(m.AppState[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppState == nil {
+				m.AppState = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InitChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevStateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevStateRoot = append(m.PrevStateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevStateRoot == nil {
+				m.PrevStateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedStateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedStateRoot = append(m.UpdatedStateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.UpdatedStateRoot == nil {
+				m.UpdatedStateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetFinalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetFinalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetFinalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetFinalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetFinalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetFinalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExecution(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExecution
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExecution
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExecution
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExecution        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExecution          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExecution = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1135 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollkit/export.proto
+
+package rollkit
+
+import (
+	fmt "fmt"
+	types "github.com/cometbft/cometbft/abci/types"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExportHeader describes the content of a chain export file.
+type ExportHeader struct {
+	Version    uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	FromHeight uint64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   uint64 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *ExportHeader) Reset()         { *m = ExportHeader{} }
+func (m *ExportHeader) String() string { return proto.CompactTextString(m) }
+func (*ExportHeader) ProtoMessage()    {}
+func (*ExportHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_008222695074617a, []int{0}
+}
+func (m *ExportHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportHeader.Merge(m, src)
+}
+func (m *ExportHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportHeader proto.InternalMessageInfo
+
+func (m *ExportHeader) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ExportHeader) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ExportHeader) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *ExportHeader) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// ExportBlock is a block with its commit and block responses.
+type ExportBlock struct {
+	Block     *Block                       `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Commit    *Commit                      `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Responses *types.ResponseFinalizeBlock `protobuf:"bytes,3,opt,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (m *ExportBlock) Reset()         { *m = ExportBlock{} }
+func (m *ExportBlock) String() string { return proto.CompactTextString(m) }
+func (*ExportBlock) ProtoMessage()    {}
+func (*ExportBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_008222695074617a, []int{1}
+}
+func (m *ExportBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportBlock.Merge(m, src)
+}
+func (m *ExportBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportBlock proto.InternalMessageInfo
+
+func (m *ExportBlock) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *ExportBlock) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *ExportBlock) GetResponses() *types.ResponseFinalizeBlock {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+// ExportRecord is a single record of a chain export file.
+type ExportRecord struct {
+	// Types that are valid to be assigned to Record:
+	//	*ExportRecord_Header
+	//	*ExportRecord_Block
+	//	*ExportRecord_State
+	Record isExportRecord_Record `protobuf_oneof:"record"`
+}
+
+func (m *ExportRecord) Reset()         { *m = ExportRecord{} }
+func (m *ExportRecord) String() string { return proto.CompactTextString(m) }
+func (*ExportRecord) ProtoMessage()    {}
+func (*ExportRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_008222695074617a, []int{2}
+}
+func (m *ExportRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRecord.Merge(m, src)
+}
+func (m *ExportRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRecord proto.InternalMessageInfo
+
+type isExportRecord_Record interface {
+	isExportRecord_Record()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ExportRecord_Header struct {
+	Header *ExportHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof" json:"header,omitempty"`
+}
+type ExportRecord_Block struct {
+	Block *ExportBlock `protobuf:"bytes,2,opt,name=block,proto3,oneof" json:"block,omitempty"`
+}
+type ExportRecord_State struct {
+	State *State `protobuf:"bytes,3,opt,name=state,proto3,oneof" json:"state,omitempty"`
+}
+
+func (*ExportRecord_Header) isExportRecord_Record() {}
+func (*ExportRecord_Block) isExportRecord_Record()  {}
+func (*ExportRecord_State) isExportRecord_Record()  {}
+
+func (m *ExportRecord) GetRecord() isExportRecord_Record {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+func (m *ExportRecord) GetHeader() *ExportHeader {
+	if x, ok := m.GetRecord().(*ExportRecord_Header); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (m *ExportRecord) GetBlock() *ExportBlock {
+	if x, ok := m.GetRecord().(*ExportRecord_Block); ok {
+		return x.Block
+	}
+	return nil
+}
+
+func (m *ExportRecord) GetState() *State {
+	if x, ok := m.GetRecord().(*ExportRecord_State); ok {
+		return x.State
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ExportRecord) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ExportRecord_Header)(nil),
+		(*ExportRecord_Block)(nil),
+		(*ExportRecord_State)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*ExportHeader)(nil), "rollkit.ExportHeader")
+	proto.RegisterType((*ExportBlock)(nil), "rollkit.ExportBlock")
+	proto.RegisterType((*ExportRecord)(nil), "rollkit.ExportRecord")
+}
+
+func init() { proto.RegisterFile("rollkit/export.proto", fileDescriptor_008222695074617a) }
+
+var fileDescriptor_008222695074617a = []byte{
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0xcd, 0x6e, 0xda, 0x40,
+	0x10, 0xf6, 0x16, 0x30, 0xb0, 0xae, 0x5a, 0x69, 0x0b, 0x92, 0x0b, 0x92, 0x8b, 0x50, 0x45, 0x91,
+	0x5a, 0xd9, 0x12, 0x7d, 0x03, 0x5a, 0x2a, 0xf7, 0xea, 0xde, 0x7a, 0x41, 0xfe, 0xd9, 0xe0, 0x15,
+	0xb6, 0xd7, 0x5a, 0x6f, 0xa2, 0x24, 0xb7, 0xbc, 0x41, 0xde, 0x20, 0x52, 0x9e, 0x26, 0x47, 0x8e,
+	0x39, 0x46, 0xf0, 0x22, 0x91, 0x67, 0xbd, 0x10, 0x71, 0xb2, 0xe7, 0xfb, 0xbe, 0xd1, 0x7c, 0xf3,
+	0xed, 0xe0, 0x81, 0xe0, 0x59, 0xb6, 0x65, 0xd2, 0xa3, 0xd7, 0x25, 0x17, 0xd2, 0x2d, 0x05, 0x97,
+	0x9c, 0x74, 0x1b, 0x74, 0x34, 0x96, 0xb4, 0x48, 0xa8, 0xc8, 0x59, 0x21, 0xbd, 0x30, 0x8a, 0x99,
+	0x27, 0x6f, 0x4a, 0x5a, 0x29, 0xd5, 0x68, 0xa8, 0x7b, 0x9b, 0x6f, 0x03, 0x7f, 0xd2, 0x70, 0x25,
+	0x43, 0x49, 0x15, 0x38, 0xbd, 0x43, 0xf8, 0xfd, 0x0a, 0x46, 0xf8, 0x34, 0x4c, 0xa8, 0x20, 0x36,
+	0xee, 0x5e, 0x51, 0x51, 0x31, 0x5e, 0xd8, 0x68, 0x82, 0xe6, 0xed, 0x40, 0x97, 0xe4, 0x33, 0xee,
+	0xc5, 0x69, 0xc8, 0x8a, 0x35, 0x4b, 0xec, 0x77, 0x13, 0x34, 0xef, 0x07, 0x5d, 0xa8, 0xff, 0x26,
+	0xe4, 0x0b, 0xb6, 0x2e, 0x04, 0xcf, 0xd7, 0x29, 0x65, 0x9b, 0x54, 0xda, 0x2d, 0x68, 0xc4, 0x35,
+	0xe4, 0x03, 0x42, 0xc6, 0xb8, 0x2f, 0xb9, 0xa6, 0xdb, 0x40, 0xf7, 0x24, 0x57, 0xe4, 0xf4, 0x11,
+	0x61, 0x4b, 0x79, 0x58, 0x66, 0x3c, 0xde, 0x92, 0xaf, 0xb8, 0x13, 0xd5, 0x3f, 0x60, 0xc0, 0x5a,
+	0x7c, 0x70, 0xf5, 0x1e, 0x40, 0x07, 0x8a, 0x24, 0xdf, 0xb0, 0x19, 0xf3, 0x3c, 0x67, 0x12, 0xcc,
+	0x58, 0x8b, 0x8f, 0x47, 0xd9, 0x2f, 0x80, 0x83, 0x86, 0x26, 0xbf, 0x71, 0x5f, 0xd0, 0xaa, 0xe4,
+	0x45, 0x45, 0x2b, 0xb0, 0x66, 0x2d, 0x66, 0xee, 0x29, 0x3f, 0xb7, 0xce, 0xcf, 0x0d, 0x1a, 0xc5,
+	0x1f, 0x56, 0x84, 0x19, 0xbb, 0xa5, 0x6a, 0xd4, 0xa9, 0x71, 0xfa, 0x70, 0x0c, 0x2a, 0xa0, 0x31,
+	0x17, 0x09, 0xf1, 0xb0, 0x99, 0x42, 0x64, 0x8d, 0xcd, 0xe1, 0x71, 0xfe, 0xdb, 0x3c, 0x7d, 0x23,
+	0x68, 0x64, 0xe4, 0x87, 0x5e, 0x4b, 0xf9, 0x1d, 0x9c, 0xe9, 0x61, 0xa2, 0x6f, 0xe8, 0xf5, 0x66,
+	0xb8, 0x03, 0xef, 0x64, 0xb7, 0xce, 0x42, 0xf8, 0x57, 0xa3, 0xb5, 0x0e, 0xe8, 0x65, 0x0f, 0x9b,
+	0x02, 0x0c, 0x2d, 0x57, 0x4f, 0x7b, 0x07, 0xed, 0xf6, 0x0e, 0x7a, 0xd9, 0x3b, 0xe8, 0xfe, 0xe0,
+	0x18, 0xbb, 0x83, 0x63, 0x3c, 0x1f, 0x1c, 0xe3, 0xff, 0xf7, 0x0d, 0x93, 0xe9, 0x65, 0xe4, 0xc6,
+	0x3c, 0xf7, 0xce, 0x6e, 0x43, 0x1d, 0x8e, 0x57, 0x46, 0x1a, 0x88, 0x4c, 0x38, 0x8c, 0x9f, 0xaf,
+	0x03, 0x00, 0x81, 0x1a, 0x31, 0xf4, 0x82, 0x02, 0x00, 0x00,
+}
+
+func (m *ExportHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintExport(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintExport(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintExport(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintExport(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Responses != nil {
+		{
+			size, err := m.Responses.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		{
+			size := m.Record.Size()
+			i -= size
+			if _, err := m.Record.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportRecord_Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportRecord_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ExportRecord_Block) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportRecord_Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ExportRecord_State) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportRecord_State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintExport(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExport(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExportHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovExport(uint64(m.Version))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovExport(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovExport(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovExport(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *ExportBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovExport(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovExport(uint64(l))
+	}
+	if m.Responses != nil {
+		l = m.Responses.Size()
+		n += 1 + l + sovExport(uint64(l))
+	}
+	return n
+}
+
+func (m *ExportRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		n += m.Record.Size()
+	}
+	return n
+}
+
+func (m *ExportRecord_Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovExport(uint64(l))
+	}
+	return n
+}
+func (m *ExportRecord_Block) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovExport(uint64(l))
+	}
+	return n
+}
+func (m *ExportRecord_State) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovExport(uint64(l))
+	}
+	return n
+}
+
+func sovExport(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExport(x uint64) (n int) {
+	return sovExport(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExportHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Responses == nil {
+				m.Responses = &types.ResponseFinalizeBlock{}
+			}
+			if err := m.Responses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExportHeader{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Record = &ExportRecord_Header{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExportBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Record = &ExportRecord_Block{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &State{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Record = &ExportRecord_State{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExport(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExport
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExport
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExport
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExport
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExport        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExport          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExport = fmt.Errorf("proto: unexpected end of group")
+)
@@ -335,6 +335,8 @@ func (m *SignedHeader) GetValidators() *types.ValidatorSet {
 
 type Data struct {
 	Txs [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	// repeated bytes intermediate_state_roots = 2;
+	Evidence []*types.Evidence `protobuf:"bytes,3,rep,name=evidence,proto3" json:"evidence,omitempty"`
 }
 
 func (m *Data) Reset()         { *m = Data{} }
@@ -377,6 +379,13 @@ func (m *Data) GetTxs() [][]byte {
 	return nil
 }
 
+func (m *Data) GetEvidence() []*types.Evidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
 type Block struct {
 	SignedHeader *SignedHeader `protobuf:"bytes,1,opt,name=signed_header,json=signedHeader,proto3" json:"signed_header,omitempty"`
 	Data         *Data         `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -429,6 +438,52 @@ func (m *Block) GetData() *Data {
 	return nil
 }
 
+// BlockSignedHeader has the same encoding as Block without Data, and is used to decode only the signed header of an
+// encoded Block.
+type BlockSignedHeader struct {
+	SignedHeader *SignedHeader `protobuf:"bytes,1,opt,name=signed_header,json=signedHeader,proto3" json:"signed_header,omitempty"`
+}
+
+func (m *BlockSignedHeader) Reset()         { *m = BlockSignedHeader{} }
+func (m *BlockSignedHeader) String() string { return proto.CompactTextString(m) }
+func (*BlockSignedHeader) ProtoMessage()    {}
+func (*BlockSignedHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed489fb7f4d78b3f, []int{6}
+}
+func (m *BlockSignedHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockSignedHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockSignedHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockSignedHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSignedHeader.Merge(m, src)
+}
+func (m *BlockSignedHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockSignedHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSignedHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSignedHeader proto.InternalMessageInfo
+
+func (m *BlockSignedHeader) GetSignedHeader() *SignedHeader {
+	if m != nil {
+		return m.SignedHeader
+	}
+	return nil
+}
+
 type TxWithISRs struct {
 	PreIsr  []byte `protobuf:"bytes,1,opt,name=pre_isr,json=preIsr,proto3" json:"pre_isr,omitempty"`
 	Tx      []byte `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
@@ -439,7 +494,7 @@ func (m *TxWithISRs) Reset()         { *m = TxWithISRs{} }
 func (m *TxWithISRs) String() string { return proto.CompactTextString(m) }
 func (*TxWithISRs) ProtoMessage()    {}
 func (*TxWithISRs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed489fb7f4d78b3f, []int{6}
+	return fileDescriptor_ed489fb7f4d78b3f, []int{7}
 }
 func (m *TxWithISRs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,6 +544,67 @@ func (m *TxWithISRs) GetPostIsr() []byte {
 	return nil
 }
 
+// ValidityProof attests to the correct execution of the block at given height.
+type ValidityProof struct {
+	Height    uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash []byte `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Proof     []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *ValidityProof) Reset()         { *m = ValidityProof{} }
+func (m *ValidityProof) String() string { return proto.CompactTextString(m) }
+func (*ValidityProof) ProtoMessage()    {}
+func (*ValidityProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed489fb7f4d78b3f, []int{8}
+}
+func (m *ValidityProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidityProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidityProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidityProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidityProof.Merge(m, src)
+}
+func (m *ValidityProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidityProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidityProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidityProof proto.InternalMessageInfo
+
+func (m *ValidityProof) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValidityProof) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *ValidityProof) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Version)(nil), "rollkit.Version")
 	proto.RegisterType((*Header)(nil), "rollkit.Header")
@@ -496,50 +612,57 @@ func init() {
 	proto.RegisterType((*SignedHeader)(nil), "rollkit.SignedHeader")
 	proto.RegisterType((*Data)(nil), "rollkit.Data")
 	proto.RegisterType((*Block)(nil), "rollkit.Block")
+	proto.RegisterType((*BlockSignedHeader)(nil), "rollkit.BlockSignedHeader")
 	proto.RegisterType((*TxWithISRs)(nil), "rollkit.TxWithISRs")
+	proto.RegisterType((*ValidityProof)(nil), "rollkit.ValidityProof")
 }
 
 func init() { proto.RegisterFile("rollkit/rollkit.proto", fileDescriptor_ed489fb7f4d78b3f) }
 
 var fileDescriptor_ed489fb7f4d78b3f = []byte{
-	// 587 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0xcf, 0x6e, 0x13, 0x3f,
-	0x10, 0xc7, 0xbb, 0x4d, 0x9a, 0x6d, 0xa7, 0xdb, 0x36, 0x3f, 0xeb, 0x57, 0x58, 0x40, 0x5a, 0x85,
-	0x95, 0x10, 0xa1, 0x48, 0x89, 0x28, 0x37, 0x0e, 0x48, 0x14, 0x90, 0x9a, 0x1b, 0x72, 0x51, 0x91,
-	0xb8, 0x44, 0x4e, 0xd6, 0x64, 0xad, 0x26, 0x6b, 0xcb, 0x76, 0xaa, 0xf0, 0x16, 0x3c, 0x02, 0x0f,
-	0xc0, 0x83, 0x70, 0xec, 0x91, 0x23, 0x6a, 0x5e, 0x04, 0x79, 0xec, 0xdd, 0x06, 0x4e, 0xeb, 0xf9,
-	0xce, 0xc7, 0xf3, 0x67, 0x67, 0x0c, 0xc7, 0x5a, 0xce, 0xe7, 0x57, 0xc2, 0x0e, 0xc3, 0x77, 0xa0,
-	0xb4, 0xb4, 0x92, 0xc4, 0xc1, 0x7c, 0xd8, 0xb3, 0xbc, 0x2a, 0xb8, 0x5e, 0x88, 0xca, 0x0e, 0xed,
-	0x57, 0xc5, 0xcd, 0xf0, 0x9a, 0xcd, 0x45, 0xc1, 0xac, 0xd4, 0x1e, 0xcd, 0x5f, 0x40, 0x7c, 0xc9,
-	0xb5, 0x11, 0xb2, 0x22, 0xff, 0xc3, 0xce, 0x64, 0x2e, 0xa7, 0x57, 0x69, 0xd4, 0x8b, 0xfa, 0x6d,
-	0xea, 0x0d, 0xd2, 0x85, 0x16, 0x53, 0x2a, 0xdd, 0x46, 0xcd, 0x1d, 0xf3, 0x1f, 0x2d, 0xe8, 0x9c,
-	0x73, 0x56, 0x70, 0x4d, 0x4e, 0x20, 0xbe, 0xf6, 0xb7, 0xf1, 0xd2, 0xfe, 0x69, 0x77, 0x50, 0x57,
-	0x12, 0xa2, 0xd2, 0x1a, 0x20, 0xf7, 0xa0, 0x53, 0x72, 0x31, 0x2b, 0x6d, 0x88, 0x15, 0x2c, 0x42,
-	0xa0, 0x6d, 0xc5, 0x82, 0xa7, 0x2d, 0x54, 0xf1, 0x4c, 0xfa, 0xd0, 0x9d, 0x33, 0x63, 0xc7, 0x25,
-	0xa6, 0x19, 0x97, 0xcc, 0x94, 0x69, 0xbb, 0x17, 0xf5, 0x13, 0x7a, 0xe8, 0x74, 0x9f, 0xfd, 0x9c,
-	0x99, 0xb2, 0x21, 0xa7, 0x72, 0xb1, 0x10, 0xd6, 0x93, 0x3b, 0x77, 0xe4, 0x5b, 0x94, 0x91, 0x7c,
-	0x04, 0x7b, 0x05, 0xb3, 0xcc, 0x23, 0x1d, 0x44, 0x76, 0x9d, 0x80, 0xce, 0x27, 0x70, 0x38, 0x95,
-	0x95, 0xe1, 0x95, 0x59, 0x1a, 0x4f, 0xc4, 0x48, 0x1c, 0x34, 0x2a, 0x62, 0x0f, 0x60, 0x97, 0x29,
-	0xe5, 0x81, 0x5d, 0x04, 0x62, 0xa6, 0x14, 0xba, 0x4e, 0xe0, 0x3f, 0x2c, 0x44, 0x73, 0xb3, 0x9c,
-	0xdb, 0x10, 0x64, 0x0f, 0x99, 0x23, 0xe7, 0xa0, 0x5e, 0x47, 0xf6, 0x19, 0x74, 0x95, 0x96, 0x4a,
-	0x1a, 0xae, 0xc7, 0xac, 0x28, 0x34, 0x37, 0x26, 0x05, 0x8f, 0xd6, 0xfa, 0x1b, 0x2f, 0xbb, 0xc2,
-	0x9a, 0x91, 0xf9, 0x98, 0xfb, 0xbe, 0xb0, 0x46, 0xad, 0x0b, 0x9b, 0x96, 0x4c, 0x54, 0x63, 0x51,
-	0xa4, 0x49, 0x2f, 0xea, 0xef, 0xd1, 0x18, 0xed, 0x51, 0x91, 0xf7, 0xa1, 0xe3, 0xff, 0x02, 0xc9,
-	0x00, 0x8c, 0x98, 0x55, 0xcc, 0x2e, 0x35, 0x37, 0x69, 0xd4, 0x6b, 0xf5, 0x13, 0xba, 0xa1, 0xe4,
-	0xdf, 0x23, 0x48, 0x2e, 0xc4, 0xac, 0xe2, 0x45, 0x18, 0xef, 0x53, 0x37, 0x32, 0x77, 0x0a, 0xd3,
-	0x3d, 0x6a, 0xa6, 0xeb, 0x01, 0x1a, 0xdc, 0x0e, 0xf4, 0x03, 0xc0, 0xd9, 0x6e, 0x82, 0x3e, 0x35,
-	0x0d, 0x6e, 0xf2, 0x1a, 0xa0, 0x29, 0xdc, 0xe0, 0xc8, 0xf7, 0x4f, 0xb3, 0xc1, 0xdd, 0x96, 0x0e,
-	0x70, 0x4b, 0x07, 0x97, 0x35, 0x73, 0xc1, 0x2d, 0xdd, 0xb8, 0x91, 0xa7, 0xd0, 0x7e, 0xc7, 0x2c,
-	0x73, 0x5b, 0x69, 0x57, 0x75, 0x0f, 0xee, 0x98, 0x7f, 0x81, 0x9d, 0x33, 0x5c, 0xd8, 0x57, 0x70,
-	0x60, 0xb0, 0x89, 0xf1, 0x5f, 0xb5, 0x1f, 0x37, 0x25, 0x6d, 0xb6, 0x48, 0x13, 0xb3, 0xd9, 0xf0,
-	0x63, 0x68, 0xbb, 0x95, 0x08, 0x5d, 0x1c, 0x34, 0x57, 0x5c, 0x4e, 0x8a, 0xae, 0xfc, 0x03, 0xc0,
-	0xc7, 0xd5, 0x27, 0x61, 0xcb, 0xd1, 0x05, 0x35, 0xe4, 0x3e, 0xc4, 0x4a, 0xf3, 0xb1, 0x30, 0x3e,
-	0x4d, 0x42, 0x3b, 0x4a, 0xf3, 0x91, 0xd1, 0xe4, 0x10, 0xb6, 0xed, 0x0a, 0xe3, 0x24, 0x74, 0xdb,
-	0xae, 0xdc, 0x80, 0x94, 0x34, 0x16, 0xc9, 0x96, 0xdf, 0x1c, 0x67, 0x8f, 0x8c, 0x3e, 0x7b, 0xff,
-	0xf3, 0x36, 0x8b, 0x6e, 0x6e, 0xb3, 0xe8, 0xf7, 0x6d, 0x16, 0x7d, 0x5b, 0x67, 0x5b, 0x37, 0xeb,
-	0x6c, 0xeb, 0xd7, 0x3a, 0xdb, 0xfa, 0xfc, 0x7c, 0x26, 0x6c, 0xb9, 0x9c, 0x0c, 0xa6, 0x72, 0x31,
-	0xfc, 0xe7, 0xa5, 0x87, 0xe7, 0xac, 0x26, 0xb5, 0x30, 0xe9, 0xe0, 0x83, 0x7e, 0xf9, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0xbc, 0x45, 0x6f, 0x2b, 0x14, 0x04, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x97, 0xfe, 0xef, 0x69, 0xbb, 0x75, 0xd1, 0x06, 0x61, 0x88, 0x50, 0x22, 0x21, 0xc2,
+	0x90, 0x5a, 0x31, 0x24, 0x2e, 0xb8, 0x40, 0x62, 0x30, 0x69, 0xbd, 0x62, 0xf2, 0xd0, 0x90, 0x10,
+	0x52, 0xe5, 0x36, 0x5e, 0x63, 0xad, 0x4d, 0x2c, 0xdb, 0x9d, 0xba, 0xb7, 0xe0, 0x11, 0x78, 0x00,
+	0x1e, 0x84, 0xcb, 0x5d, 0x72, 0x89, 0xb6, 0x17, 0x41, 0x3e, 0x4e, 0xb2, 0x6e, 0xbb, 0xe4, 0x2a,
+	0x3e, 0x9f, 0x7f, 0x3e, 0x3e, 0xf6, 0xf9, 0x62, 0xd8, 0x96, 0xe9, 0x6c, 0x76, 0xc6, 0xf5, 0x20,
+	0xfb, 0xf6, 0x85, 0x4c, 0x75, 0xea, 0xd6, 0xb3, 0x70, 0xe7, 0xa9, 0x66, 0x49, 0xc4, 0xe4, 0x9c,
+	0x27, 0x7a, 0xa0, 0x2f, 0x04, 0x53, 0x03, 0x76, 0xce, 0x23, 0x96, 0x4c, 0x98, 0x25, 0x77, 0x7a,
+	0xf7, 0x80, 0x73, 0x3a, 0xe3, 0x11, 0xd5, 0xa9, 0xb4, 0x44, 0xf0, 0x1a, 0xea, 0x27, 0x4c, 0x2a,
+	0x9e, 0x26, 0xee, 0x16, 0x54, 0xc7, 0xb3, 0x74, 0x72, 0xe6, 0x39, 0x3d, 0x27, 0xac, 0x10, 0x1b,
+	0xb8, 0x5d, 0x28, 0x53, 0x21, 0xbc, 0x12, 0x6a, 0x66, 0x18, 0xfc, 0x2a, 0x43, 0xed, 0x90, 0xd1,
+	0x88, 0x49, 0x77, 0x17, 0xea, 0xe7, 0x76, 0x35, 0x2e, 0x6a, 0xed, 0x75, 0xfb, 0x79, 0xa9, 0x59,
+	0x56, 0x92, 0x03, 0xee, 0x03, 0xa8, 0xc5, 0x8c, 0x4f, 0x63, 0x9d, 0xe5, 0xca, 0x22, 0xd7, 0x85,
+	0x8a, 0xe6, 0x73, 0xe6, 0x95, 0x51, 0xc5, 0xb1, 0x1b, 0x42, 0x77, 0x46, 0x95, 0x1e, 0xc5, 0xb8,
+	0xcd, 0x28, 0xa6, 0x2a, 0xf6, 0x2a, 0x3d, 0x27, 0x6c, 0x93, 0x75, 0xa3, 0xdb, 0xdd, 0x0f, 0xa9,
+	0x8a, 0x0b, 0x72, 0x92, 0xce, 0xe7, 0x5c, 0x5b, 0xb2, 0x7a, 0x43, 0x7e, 0x44, 0x19, 0xc9, 0xc7,
+	0xd0, 0x8c, 0xa8, 0xa6, 0x16, 0xa9, 0x21, 0xd2, 0x30, 0x02, 0x4e, 0x3e, 0x87, 0xf5, 0x49, 0x9a,
+	0x28, 0x96, 0xa8, 0x85, 0xb2, 0x44, 0x1d, 0x89, 0x4e, 0xa1, 0x22, 0xf6, 0x08, 0x1a, 0x54, 0x08,
+	0x0b, 0x34, 0x10, 0xa8, 0x53, 0x21, 0x70, 0x6a, 0x17, 0x36, 0xb1, 0x10, 0xc9, 0xd4, 0x62, 0xa6,
+	0xb3, 0x24, 0x4d, 0x64, 0x36, 0xcc, 0x04, 0xb1, 0x3a, 0xb2, 0x2f, 0xa1, 0x2b, 0x64, 0x2a, 0x52,
+	0xc5, 0xe4, 0x88, 0x46, 0x91, 0x64, 0x4a, 0x79, 0x60, 0xd1, 0x5c, 0xff, 0x60, 0x65, 0x53, 0x58,
+	0xd1, 0x32, 0x9b, 0xb3, 0x65, 0x0b, 0x2b, 0xd4, 0xbc, 0xb0, 0x49, 0x4c, 0x79, 0x32, 0xe2, 0x91,
+	0xd7, 0xee, 0x39, 0x61, 0x93, 0xd4, 0x31, 0x1e, 0x46, 0x41, 0x08, 0x35, 0x7b, 0x0b, 0xae, 0x0f,
+	0xa0, 0xf8, 0x34, 0xa1, 0x7a, 0x21, 0x99, 0xf2, 0x9c, 0x5e, 0x39, 0x6c, 0x93, 0x15, 0x25, 0xf8,
+	0xe9, 0x40, 0xfb, 0x98, 0x4f, 0x13, 0x16, 0x65, 0xed, 0x7d, 0x61, 0x5a, 0x66, 0x46, 0x59, 0x77,
+	0x37, 0x8a, 0xee, 0x5a, 0x80, 0xd4, 0xe2, 0x02, 0xb4, 0x0d, 0xf0, 0x4a, 0x77, 0x40, 0xbb, 0x35,
+	0xc9, 0xa6, 0xdd, 0xf7, 0x00, 0x45, 0xe1, 0x0a, 0x5b, 0xde, 0xda, 0xf3, 0xfb, 0x37, 0x2e, 0xed,
+	0xa3, 0x4b, 0xfb, 0x27, 0x39, 0x73, 0xcc, 0x34, 0x59, 0x59, 0x11, 0x1c, 0x41, 0xe5, 0x13, 0xd5,
+	0xd4, 0xb8, 0x52, 0x2f, 0xf3, 0x33, 0x98, 0xa1, 0xfb, 0x16, 0x1a, 0xb9, 0xf9, 0xbd, 0x72, 0xaf,
+	0x1c, 0xb6, 0xf6, 0x76, 0xee, 0xe7, 0x3d, 0xc8, 0x08, 0x52, 0xb0, 0xc1, 0x29, 0x54, 0xf7, 0xd1,
+	0xe8, 0xef, 0xa0, 0xa3, 0xf0, 0xf0, 0xa3, 0x5b, 0x67, 0xde, 0x2e, 0x8e, 0xb2, 0x7a, 0x35, 0xa4,
+	0xad, 0x56, 0x2f, 0xea, 0x19, 0x54, 0x8c, 0x95, 0xb2, 0xd3, 0x77, 0x8a, 0x25, 0xa6, 0x56, 0x82,
+	0x53, 0xc1, 0x67, 0xd8, 0xc4, 0x7d, 0x6e, 0x5d, 0xf0, 0x7f, 0xec, 0x19, 0x1c, 0x01, 0x7c, 0x59,
+	0x7e, 0xe5, 0x3a, 0x1e, 0x1e, 0x13, 0xe5, 0x3e, 0x84, 0xba, 0x90, 0x6c, 0xc4, 0x95, 0xcd, 0xd1,
+	0x26, 0x35, 0x21, 0xd9, 0x50, 0x49, 0x77, 0x1d, 0x4a, 0x7a, 0x89, 0x85, 0xb5, 0x49, 0x49, 0x2f,
+	0x8d, 0x53, 0x44, 0xaa, 0x34, 0x92, 0x65, 0x6b, 0x61, 0x13, 0x0f, 0x95, 0x0c, 0xbe, 0x43, 0x07,
+	0x2f, 0x9e, 0xeb, 0x8b, 0x23, 0x99, 0xa6, 0xa7, 0x2b, 0xbf, 0xac, 0x73, 0xeb, 0x97, 0x7d, 0x02,
+	0x80, 0x8f, 0x83, 0x35, 0xa4, 0xcd, 0xdd, 0x44, 0x05, 0xcd, 0xb8, 0x05, 0x55, 0x61, 0xd6, 0x67,
+	0xf9, 0x6d, 0xb0, 0x7f, 0xf0, 0xfb, 0xca, 0x77, 0x2e, 0xaf, 0x7c, 0xe7, 0xef, 0x95, 0xef, 0xfc,
+	0xb8, 0xf6, 0xd7, 0x2e, 0xaf, 0xfd, 0xb5, 0x3f, 0xd7, 0xfe, 0xda, 0xb7, 0x57, 0x53, 0xae, 0xe3,
+	0xc5, 0xb8, 0x3f, 0x49, 0xe7, 0x83, 0x3b, 0x2f, 0x5e, 0xf6, 0x6a, 0x89, 0x71, 0x2e, 0x8c, 0x6b,
+	0xf8, 0x6e, 0xbd, 0xf9, 0x37, 0x00, 0x5a, 0x60, 0x80, 0x44, 0x1c, 0x05, 0x00, 0x00,
 }
 
 func (m *Version) Marshal() (dAtA []byte, err error) {
@@ -794,6 +917,20 @@ func (m *Data) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollkit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Txs) > 0 {
 		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Txs[iNdEx])
@@ -853,6 +990,41 @@ func (m *Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockSignedHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockSignedHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockSignedHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignedHeader != nil {
+		{
+			size, err := m.SignedHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollkit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TxWithISRs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -897,6 +1069,48 @@ func (m *TxWithISRs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidityProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidityProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidityProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintRollkit(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintRollkit(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintRollkit(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRollkit(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRollkit(v)
 	base := offset
@@ -1026,6 +1240,12 @@ func (m *Data) Size() (n int) {
 			n += 1 + l + sovRollkit(uint64(l))
 		}
 	}
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovRollkit(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1046,6 +1266,19 @@ func (m *Block) Size() (n int) {
 	return n
 }
 
+func (m *BlockSignedHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedHeader != nil {
+		l = m.SignedHeader.Size()
+		n += 1 + l + sovRollkit(uint64(l))
+	}
+	return n
+}
+
 func (m *TxWithISRs) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1067,6 +1300,26 @@ func (m *TxWithISRs) Size() (n int) {
 	return n
 }
 
+func (m *ValidityProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRollkit(uint64(m.Height))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovRollkit(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovRollkit(uint64(l))
+	}
+	return n
+}
+
 func sovRollkit(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1890,6 +2143,40 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
 			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollkit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, &types.Evidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollkit(dAtA[iNdEx:])
@@ -2033,6 +2320,92 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlockSignedHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollkit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockSignedHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockSignedHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollkit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignedHeader == nil {
+				m.SignedHeader = &SignedHeader{}
+			}
+			if err := m.SignedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollkit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TxWithISRs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2185,6 +2558,143 @@ func (m *TxWithISRs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidityProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollkit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidityProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidityProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollkit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollkit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollkit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollkit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRollkit(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+
+	cmcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+)
+
+var (
+	// ErrPreConfirmationNoTxHash is returned when pre-confirmation doesn't reference a transaction.
+	ErrPreConfirmationNoTxHash = errors.New("pre-confirmation has invalid tx hash")
+
+	// ErrPreConfirmationHeight is returned when promised height is not after the height of issuance.
+	ErrPreConfirmationHeight = errors.New("pre-confirmation height must be greater than issued height")
+
+	// ErrPreConfirmationNoSignature is returned when pre-confirmation is not signed.
+	ErrPreConfirmationNoSignature = errors.New("pre-confirmation is not signed")
+
+	// ErrPreConfirmationSignature is returned when pre-confirmation signature is invalid.
+	ErrPreConfirmationSignature = errors.New("invalid pre-confirmation signature")
+)
+
+// PreConfirmation is a promise of the sequencer to include a transaction in a block.
+//
+// Sequencer promises that transaction is included in a block with height not greater than Height, and time not
+// after Expiry.
+type PreConfirmation struct {
+	ChainID string `json:"chain_id"`
+	// TxHash is the hash of pre-confirmed transaction.
+	TxHash cmbytes.HexBytes `json:"tx_hash"`
+	// IssuedHeight is the height of the last block produced when pre-confirmation was issued.
+	IssuedHeight uint64 `json:"issued_height"`
+	// Height is the maximum height of the block including transaction.
+	Height uint64 `json:"height"`
+	// Expiry is the latest time of the block including transaction.
+	Expiry time.Time `json:"expiry"`
+	// Signature of SignBytes created with the proposer key.
+	Signature []byte `json:"signature"`
+}
+
+// SignBytes returns bytes signed by the sequencer.
+func (p *PreConfirmation) SignBytes() []byte {
+	buf := make([]byte, 0, len(p.ChainID)+len(p.TxHash)+24)
+	buf = append(buf, p.ChainID...)
+	buf = append(buf, p.TxHash...)
+	buf = binary.BigEndian.AppendUint64(buf, p.IssuedHeight)
+	buf = binary.BigEndian.AppendUint64(buf, p.Height)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(p.Expiry.UnixNano()))
+	return buf
+}
+
+// ValidateBasic performs basic validation of a pre-confirmation.
+func (p *PreConfirmation) ValidateBasic() error {
+	if len(p.TxHash) != tmhash.Size {
+		return ErrPreConfirmationNoTxHash
+	}
+	if p.Height <= p.IssuedHeight {
+		return ErrPreConfirmationHeight
+	}
+	if len(p.Signature) == 0 {
+		return ErrPreConfirmationNoSignature
+	}
+	return nil
+}
+
+// Verify checks that pre-confirmation is valid and signed with the given key.
+func (p *PreConfirmation) Verify(pubKey cmcrypto.PubKey) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(p.SignBytes(), p.Signature) {
+		return ErrPreConfirmationSignature
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding"
+	"errors"
+)
+
+var (
+	// ErrProofEmpty is returned when validity proof doesn't contain any proof bytes.
+	ErrProofEmpty = errors.New("validity proof is empty")
+
+	// ErrProofNoBlockHash is returned when validity proof doesn't reference a block.
+	ErrProofNoBlockHash = errors.New("validity proof has no block hash")
+)
+
+// ValidityProof attests to the correct execution of a block.
+//
+// Proof bytes are opaque to Rollkit - they are produced by a Prover and checked by a Verifier.
+type ValidityProof struct {
+	// Height of the proven block.
+	Height uint64
+	// BlockHash is the hash of the proven block.
+	BlockHash Hash
+	// Proof contains the proof generated by a Prover.
+	Proof []byte
+}
+
+var _ encoding.BinaryMarshaler = &ValidityProof{}
+var _ encoding.BinaryUnmarshaler = &ValidityProof{}
+
+// ValidateBasic performs basic validation of a validity proof.
+func (p *ValidityProof) ValidateBasic() error {
+	if len(p.BlockHash) == 0 {
+		return ErrProofNoBlockHash
+	}
+	if len(p.Proof) == 0 {
+		return ErrProofEmpty
+	}
+	return nil
+}
@@ -193,8 +193,7 @@ func (d *Data) ToProto() *pb.Data {
 	return &pb.Data{
 		Txs: txsToByteSlices(d.Txs),
 		// IntermediateStateRoots: d.IntermediateStateRoots.RawRootsList,
-		// Note: Temporarily remove Evidence #896
-		// Evidence:               evidenceToProto(d.Evidence),
+		Evidence: evidenceToProto(d.Evidence),
 	}
 }
 
@@ -216,8 +215,11 @@ func (b *Block) FromProto(other *pb.Block) error {
 func (d *Data) FromProto(other *pb.Data) error {
 	d.Txs = byteSlicesToTxs(other.Txs)
 	// d.IntermediateStateRoots.RawRootsList = other.IntermediateStateRoots
-	// Note: Temporarily remove Evidence #896
-	// d.Evidence = evidenceFromProto(other.Evidence)
+	evidence, err := evidenceFromProto(other.Evidence)
+	if err != nil {
+		return err
+	}
+	d.Evidence = evidence
 
 	return nil
 }
@@ -276,6 +278,38 @@ func (s *State) FromProto(other *pb.State) error {
 	return nil
 }
 
+// ToProto converts ValidityProof into protobuf representation and returns it.
+func (p *ValidityProof) ToProto() *pb.ValidityProof {
+	return &pb.ValidityProof{
+		Height:    p.Height,
+		BlockHash: p.BlockHash[:],
+		Proof:     p.Proof,
+	}
+}
+
+// FromProto fills ValidityProof with data from its protobuf representation.
+func (p *ValidityProof) FromProto(other *pb.ValidityProof) error {
+	p.Height = other.Height
+	p.BlockHash = other.BlockHash
+	p.Proof = other.Proof
+	return nil
+}
+
+// MarshalBinary encodes ValidityProof into binary form and returns it.
+func (p *ValidityProof) MarshalBinary() ([]byte, error) {
+	return p.ToProto().Marshal()
+}
+
+// UnmarshalBinary decodes binary form of ValidityProof into object.
+func (p *ValidityProof) UnmarshalBinary(data []byte) error {
+	var pProof pb.ValidityProof
+	err := pProof.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	return p.FromProto(&pProof)
+}
+
 func txsToByteSlices(txs Txs) [][]byte {
 	if txs == nil {
 		return nil
@@ -298,25 +332,6 @@ func byteSlicesToTxs(bytes [][]byte) Txs {
 	return txs
 }
 
-// Note: Temporarily remove Evidence #896
-
-// func evidenceToProto(evidence EvidenceData) []*abci.Evidence {
-// 	var ret []*abci.Evidence
-// 	for _, e := range evidence.Evidence {
-// 		for i := range e.ABCI() {
-// 			ae := e.ABCI()[i]
-// 			ret = append(ret, &ae)
-// 		}
-// 	}
-// 	return ret
-// }
-
-// func evidenceFromProto(evidence []*abci.Evidence) EvidenceData {
-// 	var ret EvidenceData
-// 	// TODO(tzdybal): right now Evidence is just an interface without implementations
-// 	return ret
-// }
-
 func signaturesToByteSlices(sigs []Signature) [][]byte {
 	if sigs == nil {
 		return nil
@@ -339,19 +354,33 @@ func byteSlicesToSignatures(bytes [][]byte) []Signature {
 	return sigs
 }
 
-// ConsensusParamsFromProto converts protobuf consensus parameters to consensus parameters
+// ConsensusParamsFromProto converts protobuf consensus parameters to consensus parameters.
+//
+// Unlike cmtypes.ConsensusParamsFromProto, missing groups of params are left empty instead of causing a panic.
 func ConsensusParamsFromProto(pbParams cmproto.ConsensusParams) types.ConsensusParams {
-	c := types.ConsensusParams{
-		Block: types.BlockParams{
+	var c types.ConsensusParams
+	if pbParams.Block != nil {
+		c.Block = types.BlockParams{
 			MaxBytes: pbParams.Block.MaxBytes,
 			MaxGas:   pbParams.Block.MaxGas,
-		},
-		Validator: types.ValidatorParams{
+		}
+	}
+	if pbParams.Evidence != nil {
+		c.Evidence = types.EvidenceParams{
+			MaxAgeNumBlocks: pbParams.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  pbParams.Evidence.MaxAgeDuration,
+			MaxBytes:        pbParams.Evidence.MaxBytes,
+		}
+	}
+	if pbParams.Validator != nil {
+		c.Validator = types.ValidatorParams{
 			PubKeyTypes: pbParams.Validator.PubKeyTypes,
-		},
-		Version: types.VersionParams{
+		}
+	}
+	if pbParams.Version != nil {
+		c.Version = types.VersionParams{
 			App: pbParams.Version.App,
-		},
+		}
 	}
 	if pbParams.Abci != nil {
 		c.ABCI.VoteExtensionsEnableHeight = pbParams.Abci.GetVoteExtensionsEnableHeight()
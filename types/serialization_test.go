@@ -260,4 +260,38 @@ func TestConsensusParamsFromProto(t *testing.T) {
 	assert.Equal(t, int64(67890), params.Block.MaxGas)
 	assert.Equal(t, uint64(42), params.Version.App)
 	assert.Equal(t, []string{cmtypes.ABCIPubKeyTypeEd25519}, params.Validator.PubKeyTypes)
+
+	// all params are converted
+	expected := cmtypes.DefaultConsensusParams()
+	expected.ABCI.VoteExtensionsEnableHeight = 10
+	assert.Equal(t, *expected, ConsensusParamsFromProto(expected.ToProto()))
+
+	// missing groups of params are left empty
+	assert.Equal(t, cmtypes.ConsensusParams{}, ConsensusParamsFromProto(cmproto.ConsensusParams{}))
+}
+
+func TestValidityProofRoundTrip(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	proof := &ValidityProof{
+		Height:    7,
+		BlockHash: GetRandomBytes(32),
+		Proof:     GetRandomBytes(100),
+	}
+	require.NoError(proof.ValidateBasic())
+
+	blob, err := proof.MarshalBinary()
+	require.NoError(err)
+
+	var decoded ValidityProof
+	require.NoError(decoded.UnmarshalBinary(blob))
+	assert.Equal(t, proof, &decoded)
+
+	// validity proofs and blocks must never be mistaken for each other when posted to DA
+	var block Block
+	require.Error(block.UnmarshalBinary(blob))
+	blockBlob, err := GetRandomBlock(1, 2).MarshalBinary()
+	require.NoError(err)
+	require.Error(decoded.UnmarshalBinary(blockBlob))
 }
@@ -120,9 +120,13 @@ func validatorsEqual(val1 *cmtypes.Validator, val2 *cmtypes.Validator) bool {
 }
 
 // ValidateBasic performs basic validation of a signed header.
+//
+// Time ahead of the local clock (ErrTimeTooFarInFuture) is reported only if the signed header is otherwise valid, as it
+// becomes valid once the local clock catches up.
 func (sh *SignedHeader) ValidateBasic() error {
-	if err := sh.Header.ValidateBasic(); err != nil {
-		return err
+	timeErr := sh.Header.ValidateBasic()
+	if timeErr != nil && !errors.Is(timeErr, ErrTimeTooFarInFuture) {
+		return timeErr
 	}
 
 	if err := sh.Commit.ValidateBasic(); err != nil {
@@ -159,7 +163,7 @@ func (sh *SignedHeader) ValidateBasic() error {
 	if !sh.Validators.Validators[0].PubKey.VerifySignature(vote, signature) {
 		return ErrSignatureVerificationFailed
 	}
-	return nil
+	return timeErr
 }
 
 var _ header.Header[*SignedHeader] = &SignedHeader{}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/celestiaorg/go-header"
 	"github.com/cometbft/cometbft/crypto/ed25519"
@@ -91,6 +92,19 @@ func testVerify(t *testing.T, trusted *SignedHeader, untrustedAdj *SignedHeader,
 				Reason: ErrProposerVerificationFailed,
 			},
 		},
+		// 6. Test time not increasing
+		// sets the untrusted header time to the time of the trusted header
+		// Expect failure
+		{
+			prepare: func() (*SignedHeader, bool) {
+				untrusted := *untrustedAdj
+				untrusted.Header.BaseHeader.Time = trusted.Header.BaseHeader.Time
+				return &untrusted, true
+			},
+			err: &header.VerifyError{
+				Reason: ErrNonMonotonicTime,
+			},
+		},
 	}
 
 	for testIndex, test := range tests {
@@ -260,6 +274,44 @@ func testValidateBasic(t *testing.T, untrustedAdj *SignedHeader, privKey ed25519
 			},
 			err: ErrSignatureEmpty,
 		},
+		// 11. Test no time
+		// Sets the time to zero
+		// Expect failure
+		{
+			prepare: func() (*SignedHeader, bool) {
+				untrusted := *untrustedAdj
+				untrusted.BaseHeader.Time = 0
+				return &untrusted, true
+			},
+			err: ErrNoTime,
+		},
+		// 12. Test time too far in the future
+		// Sets the time of a new signed header ahead of local clock by more than MaxClockDrift
+		// Expect failure
+		{
+			prepare: func() (*SignedHeader, bool) {
+				untrusted, key, err := GetRandomSignedHeader()
+				require.NoError(t, err)
+				untrusted.BaseHeader.Time = uint64(time.Now().Add(2 * MaxClockDrift).UnixNano())
+				commit, err := GetCommit(untrusted.Header, key)
+				require.NoError(t, err)
+				untrusted.Commit = *commit
+				return untrusted, false
+			},
+			err: ErrTimeTooFarInFuture,
+		},
+		// 13. Test time too far in the future with invalid signature
+		// Sets the time of a new signed header ahead of local clock by more than MaxClockDrift, without recomputing the commit
+		// Expect failure of signature verification, reported before the time
+		{
+			prepare: func() (*SignedHeader, bool) {
+				untrusted, _, err := GetRandomSignedHeader()
+				require.NoError(t, err)
+				untrusted.BaseHeader.Time = uint64(time.Now().Add(2 * MaxClockDrift).UnixNano())
+				return untrusted, false
+			},
+			err: ErrSignatureVerificationFailed,
+		},
 	}
 
 	for testIndex, test := range tests {
@@ -75,26 +75,7 @@ func NewFromGenesisDoc(genDoc *types.GenesisDoc) (State, error) {
 		LastBlockID:     types.BlockID{},
 		LastBlockTime:   genDoc.GenesisTime,
 
-		ConsensusParams: cmproto.ConsensusParams{
-			Block: &cmproto.BlockParams{
-				MaxBytes: genDoc.ConsensusParams.Block.MaxBytes,
-				MaxGas:   genDoc.ConsensusParams.Block.MaxGas,
-			},
-			Evidence: &cmproto.EvidenceParams{
-				MaxAgeNumBlocks: genDoc.ConsensusParams.Evidence.MaxAgeNumBlocks,
-				MaxAgeDuration:  genDoc.ConsensusParams.Evidence.MaxAgeDuration,
-				MaxBytes:        genDoc.ConsensusParams.Evidence.MaxBytes,
-			},
-			Validator: &cmproto.ValidatorParams{
-				PubKeyTypes: genDoc.ConsensusParams.Validator.PubKeyTypes,
-			},
-			Version: &cmproto.VersionParams{
-				App: genDoc.ConsensusParams.Version.App,
-			},
-			Abci: &cmproto.ABCIParams{
-				VoteExtensionsEnableHeight: genDoc.ConsensusParams.ABCI.VoteExtensionsEnableHeight,
-			},
-		},
+		ConsensusParams:                  genDoc.ConsensusParams.ToProto(),
 		LastHeightConsensusParamsChanged: uint64(genDoc.InitialHeight),
 	}
 	s.AppHash = genDoc.AppHash.Bytes()
@@ -143,7 +143,8 @@ type HeaderConfig struct {
 	VotingPower int64
 }
 
-// GetRandomHeader returns a header with random fields and current time
+// GetRandomHeader returns a header with random fields and current time. ConsensusHash commits to default consensus
+// params, used by test genesis.
 func GetRandomHeader() Header {
 	return Header{
 		BaseHeader: BaseHeader{
@@ -158,7 +159,7 @@ func GetRandomHeader() Header {
 		LastHeaderHash:  GetRandomBytes(32),
 		LastCommitHash:  GetRandomBytes(32),
 		DataHash:        GetRandomBytes(32),
-		ConsensusHash:   GetRandomBytes(32),
+		ConsensusHash:   getDefaultConsensusHash(),
 		AppHash:         GetRandomBytes(32),
 		LastResultsHash: GetRandomBytes(32),
 		ProposerAddress: GetRandomBytes(32),
@@ -166,6 +167,14 @@ func GetRandomHeader() Header {
 	}
 }
 
+func getDefaultConsensusHash() Hash {
+	hash, err := ConsensusHash(cmtypes.DefaultConsensusParams().ToProto())
+	if err != nil {
+		panic(err)
+	}
+	return hash
+}
+
 // GetRandomNextHeader returns a header with random data and height of +1 from
 // the provided Header
 func GetRandomNextHeader(header Header) Header {
@@ -209,7 +218,6 @@ func GetRandomSignedHeaderCustom(config *HeaderConfig) (*SignedHeader, error) {
 	signedHeader.Header.DataHash = config.DataHash
 	signedHeader.Header.ProposerAddress = valSet.Proposer.Address
 	signedHeader.Header.ValidatorHash = valSet.Hash()
-	signedHeader.Header.BaseHeader.Time = uint64(time.Now().UnixNano()) + (config.Height)*10
 
 	commit, err := GetCommit(signedHeader.Header, config.PrivKey)
 	if err != nil {
@@ -270,7 +278,7 @@ func GetFirstSignedHeader(privkey ed25519.PrivKey, valSet *cmtypes.ValidatorSet)
 		LastHeaderHash:  GetRandomBytes(32),
 		LastCommitHash:  GetRandomBytes(32),
 		DataHash:        GetRandomBytes(32),
-		ConsensusHash:   GetRandomBytes(32),
+		ConsensusHash:   getDefaultConsensusHash(),
 		AppHash:         make([]byte, 32),
 		LastResultsHash: GetRandomBytes(32),
 		ValidatorHash:   valSet.Hash(),